@@ -0,0 +1,396 @@
+package main
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+)
+
+// BindOptions configures the binding generated for a single contract.
+type BindOptions struct {
+	// Package is the name of the generated package
+	Package string
+	// Type is the name of the generated contract type, eg. "Token"
+	Type string
+	// Bytecode is the hex encoded creation bytecode of the contract, checked to be valid hex. When set, it is embedded in the binding
+	// and the deploy helper doesn't take it as an argument.
+	Bytecode string
+}
+
+type bindField struct {
+	Name string
+	Type string
+	Tag  string
+}
+
+type bindStruct struct {
+	Name   string
+	Sig    string
+	Fields []bindField
+}
+
+type bindParam struct {
+	Name string
+	Type string
+}
+
+type bindMethod struct {
+	Key     string
+	Name    string
+	Sig     string
+	Const   bool
+	Params  []bindParam
+	Outputs []bindField
+	Output  string
+}
+
+type bindEvent struct {
+	Key    string
+	Name   string
+	Sig    string
+	Fields []bindField
+}
+
+type binder struct {
+	typeName string
+	structs  []*bindStruct
+	bySig    map[string]*bindStruct
+	byName   map[string]*bindStruct
+}
+
+// Bind generates the Go source of a typed binding for the contract with the given ABI JSON
+func Bind(abiJSON io.Reader, options BindOptions) ([]byte, error) {
+	raw, err := io.ReadAll(abiJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	abi, err := hiero.NewABIFromReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+
+	if !token.IsIdentifier(options.Package) {
+		return nil, fmt.Errorf("invalid package name %q", options.Package)
+	}
+	typeName := _Exported(options.Type)
+	if typeName == "" {
+		return nil, fmt.Errorf("invalid type name %q", options.Type)
+	}
+
+	bytecode := strings.TrimPrefix(strings.TrimSpace(options.Bytecode), "0x")
+	if _, err := hex.DecodeString(bytecode); err != nil {
+		return nil, fmt.Errorf("invalid bytecode, expected hex: %w", err)
+	}
+
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, raw); err != nil {
+		return nil, err
+	}
+
+	b := &binder{
+		typeName: typeName,
+		bySig:    map[string]*bindStruct{},
+		byName:   map[string]*bindStruct{},
+	}
+
+	var methods []bindMethod
+	for _, key := range _SortedKeys(abi.Methods) {
+		method, err := b._Method(key, abi.Methods[key])
+		if err != nil {
+			return nil, err
+		}
+		methods = append(methods, method)
+	}
+
+	var events []bindEvent
+	for _, key := range _SortedKeys(abi.Events) {
+		event := abi.Events[key]
+		if event.Anonymous {
+			continue
+		}
+		fields, err := b._Fields(event.Inputs, _Exported(key)+"Event", true)
+		if err != nil {
+			return nil, err
+		}
+		for i := range fields {
+			if fields[i].Name == "Raw" {
+				fields[i].Name = "RawValue"
+			}
+		}
+		events = append(events, bindEvent{Key: key, Name: _Exported(key), Sig: event.Sig(), Fields: fields})
+	}
+
+	var constructor []bindParam
+	if abi.Constructor != nil {
+		if constructor, err = b._Params(abi.Constructor.Inputs, "Constructor"); err != nil {
+			return nil, err
+		}
+	}
+
+	var out bytes.Buffer
+	err = bindTemplate.Execute(&out, map[string]interface{}{
+		"Package":     options.Package,
+		"Type":        typeName,
+		"ABI":         strconv.Quote(compact.String()),
+		"Bytecode":    bytecode,
+		"Structs":     b.structs,
+		"Methods":     methods,
+		"Events":      events,
+		"Constructor": constructor,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting the generated binding: %w", err)
+	}
+
+	return source, nil
+}
+
+func (b *binder) _Method(key string, method *hiero.Method) (bindMethod, error) {
+	name := _Exported(key)
+	params, err := b._Params(method.Inputs, name)
+	if err != nil {
+		return bindMethod{}, err
+	}
+
+	outputs, err := b._Fields(method.Outputs, name+"Output", false)
+	if err != nil {
+		return bindMethod{}, err
+	}
+
+	result := bindMethod{
+		Key:     key,
+		Name:    name,
+		Sig:     method.Sig(),
+		Const:   method.Const,
+		Params:  params,
+		Outputs: outputs,
+	}
+
+	switch len(outputs) {
+	case 0:
+	case 1:
+		result.Output = outputs[0].Type
+	default:
+		output := &bindStruct{Name: b.typeName + name + "Output", Fields: outputs}
+		b._AddStruct(output)
+		result.Output = output.Name
+	}
+
+	return result, nil
+}
+
+// _Params returns the arguments of a generated function, with names which are valid and unique Go identifiers
+func (b *binder) _Params(tuple *hiero.Type, context string) ([]bindParam, error) {
+	params := make([]bindParam, 0, len(tuple.TupleElems()))
+	used := map[string]bool{}
+	for i, elem := range tuple.TupleElems() {
+		typ, err := b._GoType(elem.Elem, elem.Elem.InternalType(), fmt.Sprintf("%s%s", context, _FieldName(elem.Name, i)))
+		if err != nil {
+			return nil, err
+		}
+
+		name := _Unexported(elem.Name)
+		if name == "" {
+			name = "arg" + strconv.Itoa(i)
+		}
+		if token.Lookup(name).IsKeyword() || bindReserved[name] || used[name] {
+			name += strconv.Itoa(i)
+		}
+		used[name] = true
+
+		params = append(params, bindParam{Name: name, Type: typ})
+	}
+
+	return params, nil
+}
+
+// _Fields returns the struct fields of the elements of a tuple. Indexed parameters of events which are logged as
+// a hash are typed as hiero.Hash.
+func (b *binder) _Fields(tuple *hiero.Type, context string, event bool) ([]bindField, error) {
+	fields := make([]bindField, 0, len(tuple.TupleElems()))
+	used := map[string]bool{}
+	for i, elem := range tuple.TupleElems() {
+		name := _FieldName(elem.Name, i)
+		if used[name] {
+			name += strconv.Itoa(i)
+		}
+		used[name] = true
+
+		tag := elem.Name
+		if tag == "" {
+			tag = strconv.Itoa(i)
+		}
+
+		var typ string
+		if event && elem.Indexed && _IsHashedTopicKind(elem.Elem.Kind()) {
+			typ = "hiero.Hash"
+		} else {
+			var err error
+			if typ, err = b._GoType(elem.Elem, elem.Elem.InternalType(), context+name); err != nil {
+				return nil, err
+			}
+		}
+
+		fields = append(fields, bindField{Name: name, Type: typ, Tag: tag})
+	}
+
+	return fields, nil
+}
+
+// _GoType returns the Go type the ABI encoder and decoder of the SDK use for an ABI type. Tuples are mapped to
+// generated structs named after their Solidity struct, from the internal type, or after where they are used.
+func (b *binder) _GoType(typ *hiero.Type, internalType string, context string) (string, error) {
+	switch typ.Kind() {
+	case hiero.KindBool:
+		return "bool", nil
+	case hiero.KindUInt, hiero.KindInt:
+		if typ.GoType().Kind() == reflect.Ptr {
+			return "*big.Int", nil
+		}
+		return typ.GoType().String(), nil
+	case hiero.KindString:
+		return "string", nil
+	case hiero.KindBytes:
+		return "[]byte", nil
+	case hiero.KindFixedBytes:
+		return fmt.Sprintf("[%d]byte", typ.Size()), nil
+	case hiero.KindAddress:
+		return "hiero.Address", nil
+	case hiero.KindFunction:
+		return "[24]byte", nil
+	case hiero.KindSlice:
+		elem, err := b._GoType(typ.Elem(), _TrimArraySuffix(internalType), context)
+		if err != nil {
+			return "", err
+		}
+		return "[]" + elem, nil
+	case hiero.KindArray:
+		elem, err := b._GoType(typ.Elem(), _TrimArraySuffix(internalType), context)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("[%d]%s", typ.Size(), elem), nil
+	case hiero.KindTuple:
+		return b._Struct(typ, internalType, context)
+	default:
+		return "", fmt.Errorf("unsupported abi type %s", typ.String())
+	}
+}
+
+func (b *binder) _Struct(typ *hiero.Type, internalType string, context string) (string, error) {
+	sig := typ.Format(true)
+	if existing, ok := b.bySig[sig]; ok {
+		return existing.Name, nil
+	}
+
+	name := context
+	if strings.HasPrefix(internalType, "struct ") {
+		structName := strings.TrimPrefix(internalType, "struct ")
+		if dot := strings.LastIndex(structName, "."); dot >= 0 {
+			structName = structName[dot+1:]
+		}
+		name = _Exported(structName)
+	}
+	if !strings.HasPrefix(name, b.typeName) {
+		name = b.typeName + name
+	}
+
+	fields, err := b._Fields(typ, name, false)
+	if err != nil {
+		return "", err
+	}
+
+	result := &bindStruct{Name: name, Sig: sig, Fields: fields}
+	b._AddStruct(result)
+	b.bySig[sig] = result
+
+	return result.Name, nil
+}
+
+// _AddStruct adds a generated struct, suffixing its name when it is already taken
+func (b *binder) _AddStruct(result *bindStruct) {
+	base := result.Name
+	for i := 0; b.byName[result.Name] != nil; i++ {
+		result.Name = base + strconv.Itoa(i)
+	}
+	b.byName[result.Name] = result
+	b.structs = append(b.structs, result)
+}
+
+// Names of the generated code which the arguments of a function mustn't shadow
+var bindReserved = map[string]bool{
+	"c": true, "data": true, "err": true, "out": true, "hiero": true, "big": true, "bytecode": true,
+}
+
+func _IsHashedTopicKind(kind hiero.AbiTypeKind) bool {
+	switch kind {
+	case hiero.KindString, hiero.KindBytes, hiero.KindSlice, hiero.KindArray, hiero.KindTuple:
+		return true
+	default:
+		return false
+	}
+}
+
+func _TrimArraySuffix(internalType string) string {
+	if i := strings.LastIndex(internalType, "["); i >= 0 && strings.HasSuffix(internalType, "]") {
+		return internalType[:i]
+	}
+	return internalType
+}
+
+// _FieldName returns the exported name of a struct field, which is Field<i> for unnamed elements
+func _FieldName(name string, index int) string {
+	if exported := _Exported(name); exported != "" {
+		return exported
+	}
+	return "Field" + strconv.Itoa(index)
+}
+
+// _Exported converts a Solidity identifier, like _owner or balanceOf, to an exported Go identifier
+func _Exported(name string) string {
+	name = strings.TrimLeft(name, "_")
+	if name == "" {
+		return ""
+	}
+	runes := []rune(name)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
+
+func _Unexported(name string) string {
+	name = strings.TrimLeft(name, "_")
+	if name == "" {
+		return ""
+	}
+	runes := []rune(name)
+	runes[0] = unicode.ToLower(runes[0])
+	return string(runes)
+}
+
+func _SortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
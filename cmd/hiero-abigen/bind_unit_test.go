@@ -0,0 +1,97 @@
+//go:build all || unit
+// +build all unit
+
+package main
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitBindMatchesGolden(t *testing.T) {
+	t.Parallel()
+
+	file, err := os.Open("testdata/Token.abi")
+	require.NoError(t, err)
+	defer file.Close()
+
+	source, err := Bind(file, BindOptions{Package: "token", Type: "Token", Bytecode: "0x6080\n"})
+	require.NoError(t, err)
+
+	golden, err := os.ReadFile("testdata/token.go.golden")
+	require.NoError(t, err)
+	assert.Equal(t, string(golden), string(source))
+}
+
+func TestUnitBindNames(t *testing.T) {
+	t.Parallel()
+
+	abi := `[
+		{"type":"function","name":"set","inputs":[{"name":"_type","type":"uint24"},{"name":"data","type":"bytes"},{"name":"","type":"bool"}],"outputs":[]},
+		{"type":"function","name":"point","stateMutability":"pure","inputs":[],"outputs":[{"name":"","type":"tuple","components":[{"name":"x","type":"int8"},{"name":"y","type":"int8"}]}]}
+	]`
+	source, err := Bind(strings.NewReader(abi), BindOptions{Package: "names", Type: "_store"})
+	require.NoError(t, err)
+
+	code := string(source)
+	assert.Contains(t, code, "func (c *Store) Set(type0 *big.Int, data1 []byte, arg2 bool) (*hiero.ContractExecuteTransaction, error)")
+	assert.Contains(t, code, "type StorePointOutputField0 struct")
+	assert.Contains(t, code, "func (c *Store) UnpackPoint(data []byte) (StorePointOutputField0, error)")
+	assert.Contains(t, code, "func DeployStore(bytecode []byte) (*hiero.ContractCreateFlow, error)")
+	assert.NotContains(t, code, "func (c *Store) Point(")
+
+	_, err = Bind(strings.NewReader(abi), BindOptions{Package: "not-a-package", Type: "Store"})
+	require.Error(t, err)
+
+	_, err = Bind(strings.NewReader(`{}`), BindOptions{Package: "store", Type: "Store"})
+	require.Error(t, err)
+}
+
+func TestUnitBindCompiles(t *testing.T) {
+	t.Parallel()
+
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("the go tool is not available")
+	}
+
+	abi, err := os.ReadFile("testdata/Token.abi")
+	require.NoError(t, err)
+
+	dir, err := os.MkdirTemp("testdata", "compile")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// Both deploy helpers, with the bytecode embedded and taken as an argument, are compiled in one package
+	for _, options := range []BindOptions{
+		{Package: "token", Type: "Token", Bytecode: "0x6080"},
+		{Package: "token", Type: "TokenWithoutBytecode"},
+	} {
+		source, err := Bind(bytes.NewReader(abi), options)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, strings.ToLower(options.Type)+".go"), source, 0o644)) // nolint
+	}
+
+	output, err := exec.Command(goTool, "vet", "./"+filepath.ToSlash(dir)).CombinedOutput()
+	require.NoError(t, err, string(output))
+}
+
+func TestUnitBindRejectsInvalidBytecode(t *testing.T) {
+	t.Parallel()
+
+	file, err := os.Open("testdata/Token.abi")
+	require.NoError(t, err)
+	defer file.Close()
+
+	_, err = Bind(file, BindOptions{Package: "token", Type: "Token", Bytecode: "0x6080zz"})
+	require.ErrorContains(t, err, "invalid bytecode")
+}
@@ -0,0 +1,77 @@
+package main
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+/**
+ * @summary Generates a typed Go binding for a contract from its Solidity ABI
+ *
+ * Usage:
+ *   hiero-abigen -abi Token.abi -pkg token [-type Token] [-bin Token.bin] [-out token.go]
+ */
+func main() {
+	abiPath := flag.String("abi", "", "path of the ABI JSON of the contract, or - to read it from stdin")
+	binPath := flag.String("bin", "", "path of the hex encoded creation bytecode of the contract, embedded in the binding")
+	pkg := flag.String("pkg", "", "name of the generated package")
+	typeName := flag.String("type", "", "name of the generated contract type, defaults to the name of the ABI file")
+	outPath := flag.String("out", "", "path of the generated file, defaults to stdout")
+	flag.Parse()
+
+	if err := run(*abiPath, *binPath, *pkg, *typeName, *outPath); err != nil {
+		fmt.Fprintln(os.Stderr, "hiero-abigen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(abiPath string, binPath string, pkg string, typeName string, outPath string) error {
+	if abiPath == "" || pkg == "" {
+		flag.Usage()
+		return fmt.Errorf("-abi and -pkg are required")
+	}
+
+	var abiJSON io.Reader = os.Stdin
+	if abiPath != "-" {
+		file, err := os.Open(abiPath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		abiJSON = file
+
+		if typeName == "" {
+			typeName = strings.TrimSuffix(filepath.Base(abiPath), filepath.Ext(abiPath))
+		}
+	}
+	if typeName == "" {
+		return fmt.Errorf("-type is required when reading the ABI from stdin")
+	}
+
+	options := BindOptions{Package: pkg, Type: typeName}
+	if binPath != "" {
+		bytecode, err := os.ReadFile(binPath)
+		if err != nil {
+			return err
+		}
+		options.Bytecode = string(bytecode)
+	}
+
+	source, err := Bind(abiJSON, options)
+	if err != nil {
+		return err
+	}
+
+	if outPath == "" {
+		_, err = os.Stdout.Write(source)
+		return err
+	}
+
+	return os.WriteFile(outPath, source, 0o644) // nolint
+}
@@ -0,0 +1,166 @@
+package main
+
+// SPDX-License-Identifier: Apache-2.0
+
+import "text/template"
+
+var bindTemplate = template.Must(template.New("binding").Parse(`// Code generated by hiero-abigen. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"fmt"
+	"math/big"
+
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+)
+
+// Reference the imports which are only used by some bindings
+var (
+	_ = big.NewInt
+	_ = fmt.Errorf
+)
+
+// {{.Type}}ABI is the ABI of the {{.Type}} contract
+const {{.Type}}ABI = {{.ABI}}
+{{if .Bytecode}}
+// {{.Type}}Bytecode is the hex encoded creation bytecode of the {{.Type}} contract
+const {{.Type}}Bytecode = "{{.Bytecode}}"
+{{end}}
+{{range .Structs}}
+// {{.Name}} is a tuple of the {{$.Type}} contract
+type {{.Name}} struct {
+{{- range .Fields}}
+	{{.Name}} {{.Type}} ` + "`abi:\"{{.Tag}}\"`" + `
+{{- end}}
+}
+{{end}}
+{{range .Events}}
+// {{$.Type}}{{.Name}}Event is the {{.Sig}} event of the {{$.Type}} contract
+type {{$.Type}}{{.Name}}Event struct {
+{{- range .Fields}}
+	{{.Name}} {{.Type}} ` + "`abi:\"{{.Tag}}\"`" + `
+{{- end}}
+	Raw hiero.ContractLogInfo ` + "`abi:\"-\"`" + `
+}
+{{end}}
+// {{.Type}} is a typed binding to a deployed {{.Type}} contract
+type {{.Type}} struct {
+	ContractID hiero.ContractID
+	abi        *hiero.ABI
+}
+
+// New{{.Type}} creates a binding to the {{.Type}} contract deployed at contractID
+func New{{.Type}}(contractID hiero.ContractID) (*{{.Type}}, error) {
+	abi, err := hiero.NewABI({{.Type}}ABI)
+	if err != nil {
+		return nil, err
+	}
+
+	return &{{.Type}}{ContractID: contractID, abi: abi}, nil
+}
+
+// Deploy{{.Type}} returns a ContractCreateFlow which deploys the {{.Type}} contract with the constructor arguments
+func Deploy{{.Type}}({{if not .Bytecode}}bytecode []byte{{if .Constructor}}, {{end}}{{end}}{{range $i, $p := .Constructor}}{{if $i}}, {{end}}{{$p.Name}} {{$p.Type}}{{end}}) (*hiero.ContractCreateFlow, error) {
+	abi, err := hiero.NewABI({{.Type}}ABI)
+	if err != nil {
+		return nil, err
+	}
+
+	var data []byte
+	if abi.Constructor != nil {
+		if data, err = abi.Constructor.Inputs.Encode([]interface{}{ {{- range $i, $p := .Constructor}}{{if $i}}, {{end}}{{$p.Name}}{{end -}} }); err != nil {
+			return nil, err
+		}
+	}
+
+	return hiero.NewContractCreateFlow().
+		{{if .Bytecode}}SetBytecode([]byte({{.Type}}Bytecode)){{else}}SetBytecode(bytecode){{end}}.
+		SetConstructorParametersRaw(data), nil
+}
+{{range .Methods}}
+// Pack{{.Name}} encodes the call of {{.Sig}}
+func (c *{{$.Type}}) Pack{{.Name}}({{range $i, $p := .Params}}{{if $i}}, {{end}}{{$p.Name}} {{$p.Type}}{{end}}) ([]byte, error) {
+	return c.abi.Methods["{{.Key}}"].Encode([]interface{}{ {{- range $i, $p := .Params}}{{if $i}}, {{end}}{{$p.Name}}{{end -}} })
+}
+{{if not .Const}}
+// {{.Name}} returns a ContractExecuteTransaction which calls {{.Sig}}
+func (c *{{$.Type}}) {{.Name}}({{range $i, $p := .Params}}{{if $i}}, {{end}}{{$p.Name}} {{$p.Type}}{{end}}) (*hiero.ContractExecuteTransaction, error) {
+	data, err := c.Pack{{.Name}}({{range $i, $p := .Params}}{{if $i}}, {{end}}{{$p.Name}}{{end}})
+	if err != nil {
+		return nil, err
+	}
+
+	return hiero.NewContractExecuteTransaction().SetContractID(c.ContractID).SetFunctionParameters(data), nil
+}
+{{end}}
+// Call{{.Name}} returns a ContractCallQuery which calls {{.Sig}}
+func (c *{{$.Type}}) Call{{.Name}}({{range $i, $p := .Params}}{{if $i}}, {{end}}{{$p.Name}} {{$p.Type}}{{end}}) (*hiero.ContractCallQuery, error) {
+	data, err := c.Pack{{.Name}}({{range $i, $p := .Params}}{{if $i}}, {{end}}{{$p.Name}}{{end}})
+	if err != nil {
+		return nil, err
+	}
+
+	return hiero.NewContractCallQuery().SetContractID(c.ContractID).SetFunctionParameters(data), nil
+}
+
+// MirrorCall{{.Name}} returns a MirrorNodeContractCallQuery which simulates {{.Sig}}
+func (c *{{$.Type}}) MirrorCall{{.Name}}({{range $i, $p := .Params}}{{if $i}}, {{end}}{{$p.Name}} {{$p.Type}}{{end}}) (*hiero.MirrorNodeContractCallQuery, error) {
+	data, err := c.Pack{{.Name}}({{range $i, $p := .Params}}{{if $i}}, {{end}}{{$p.Name}}{{end}})
+	if err != nil {
+		return nil, err
+	}
+
+	return hiero.NewMirrorNodeContractCallQuery().SetContractID(c.ContractID).SetFunctionParameters(data), nil
+}
+{{if .Output}}
+// Unpack{{.Name}} decodes the result of {{.Sig}}, eg. the ContractCallResult of a ContractFunctionResult
+func (c *{{$.Type}}) Unpack{{.Name}}(data []byte) ({{.Output}}, error) {
+{{- if eq (len .Outputs) 1}}
+	var out struct {
+		Value {{.Output}} ` + "`abi:\"{{(index .Outputs 0).Tag}}\"`" + `
+	}
+	err := hiero.DecodeStruct(c.abi.Methods["{{.Key}}"].Outputs, data, &out)
+	return out.Value, err
+{{- else}}
+	var out {{.Output}}
+	err := hiero.DecodeStruct(c.abi.Methods["{{.Key}}"].Outputs, data, &out)
+	return out, err
+{{- end}}
+}
+{{end}}
+{{- end}}
+{{range .Events}}
+// Parse{{.Name}} decodes a log of the {{.Sig}} event
+func (c *{{$.Type}}) Parse{{.Name}}(log hiero.ContractLogInfo) (*{{$.Type}}{{.Name}}Event, error) {
+	event := c.abi.Events["{{.Key}}"]
+	if !event.Match(log) {
+		return nil, fmt.Errorf("log is not a {{.Name}} event")
+	}
+
+	out := &{{$.Type}}{{.Name}}Event{Raw: log}
+	if err := event.ParseLogStruct(log, out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// Parse{{.Name}}Logs decodes the {{.Sig}} events logged by a contract call
+func (c *{{$.Type}}) Parse{{.Name}}Logs(result hiero.ContractFunctionResult) ([]*{{$.Type}}{{.Name}}Event, error) {
+	var events []*{{$.Type}}{{.Name}}Event
+	for _, log := range result.LogInfo {
+		if !c.abi.Events["{{.Key}}"].Match(log) {
+			continue
+		}
+
+		event, err := c.Parse{{.Name}}(log)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+{{end}}`))
@@ -34,6 +34,19 @@ func (a *ABI) GetMethodBySignature(methodSignature string) *Method {
 	return m
 }
 
+// _MethodByID returns the method whose selector matches the first four bytes of the calldata
+func (a *ABI) _MethodByID(data []byte) *Method {
+	if len(data) < 4 {
+		return nil
+	}
+	for _, m := range a.Methods {
+		if bytes.Equal(m.ID(), data[:4]) {
+			return m
+		}
+	}
+	return nil
+}
+
 func (a *ABI) addError(e *Error) {
 	if len(a.Errors) == 0 {
 		a.Errors = map[string]*Error{}
@@ -257,6 +270,17 @@ func (m *Method) Decode(data []byte) (map[string]interface{}, error) {
 	return resp, nil
 }
 
+// _EncodeCall encodes the call of the method with the arguments, for the SetFunctionFromABI setters
+func _EncodeCall(method *Method, args []interface{}) ([]byte, error) {
+	if method == nil {
+		return nil, fmt.Errorf("method is required")
+	}
+	if len(args) != len(method.Inputs.tuple) {
+		return nil, fmt.Errorf("method %s expects %d arguments but got %d", method.Sig(), len(method.Inputs.tuple), len(args))
+	}
+	return method.Encode(args)
+}
+
 func NewMethod(name string) (*Method, error) {
 	name, inputs, outputs, err := parseMethodSignature(name)
 	if err != nil {
@@ -307,6 +331,20 @@ func NewError(name string) (*Error, error) {
 	return &Error{Name: name, Inputs: typ}, nil
 }
 
+// Sig returns the signature of the error
+func (e *Error) Sig() string {
+	return buildSignature(e.Name, e.Inputs)
+}
+
+// ID returns the selector of the error, which prefixes its revert data
+func (e *Error) ID() []byte {
+	k := acquireKeccak()
+	k.Write([]byte(e.Sig()))
+	dst := k.Sum(nil)[:4]
+	releaseKeccak(k)
+	return dst
+}
+
 // ArgumentStr encodes a type object
 type ArgumentStr struct {
 	Name         string
@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package hiero
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// EncodePacked encodes a value in the non-standard packed mode of Solidity's abi.encodePacked. Static types
+// take as many bytes as their size, strings and bytes are encoded as is without their length and the elements of
+// arrays are padded to 32 bytes. A tuple type stands for the list of arguments of abi.encodePacked, and can't be
+// nested. Note that the packed encoding is ambiguous as soon as it holds two dynamic values.
+func EncodePacked(v interface{}, t *Type) ([]byte, error) {
+	value := reflect.ValueOf(v)
+	if value.Kind() == reflect.Interface {
+		value = value.Elem()
+	}
+
+	if t.kind != KindTuple {
+		return encodePacked(value, t, false)
+	}
+
+	if value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+	if value.Kind() == reflect.Struct {
+		var err error
+		if value, err = mapFromStruct(value); err != nil {
+			return nil, err
+		}
+	}
+
+	var ret []byte
+	for i, elem := range t.tuple {
+		var arg reflect.Value
+		switch value.Kind() {
+		case reflect.Slice, reflect.Array:
+			if value.Len() != len(t.tuple) {
+				return nil, fmt.Errorf("expected %d values to pack, got %d", len(t.tuple), value.Len())
+			}
+			arg = value.Index(i)
+		case reflect.Map:
+			name := elem.Name
+			if name == "" {
+				name = strconv.Itoa(i)
+			}
+			arg = value.MapIndex(reflect.ValueOf(name))
+			if !arg.IsValid() {
+				return nil, fmt.Errorf("value for field '%s' not found", name)
+			}
+		default:
+			return nil, encodeErr(value, "tuple")
+		}
+
+		packed, err := encodePacked(arg, elem.Elem, false)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, packed...)
+	}
+
+	return ret, nil
+}
+
+// EncodePacked encodes a value of this type like abi.encodePacked
+func (t *Type) EncodePacked(v interface{}) ([]byte, error) {
+	return EncodePacked(v, t)
+}
+
+// SolidityPackedKeccak256 returns keccak256(abi.encodePacked(values...)), where the values have the given types,
+// eg. SolidityPackedKeccak256([]string{"address", "uint256"}, []interface{}{owner, nonce})
+func SolidityPackedKeccak256(types []string, values []interface{}) (Hash, error) {
+	elems := make([]*TupleElem, 0, len(types))
+	for _, typ := range types {
+		elem, err := NewType(typ)
+		if err != nil {
+			return Hash{}, err
+		}
+		elems = append(elems, &TupleElem{Elem: elem})
+	}
+
+	packed, err := EncodePacked(values, NewTupleType(elems))
+	if err != nil {
+		return Hash{}, err
+	}
+
+	return Keccak256Hash(packed), nil
+}
+
+// encodePacked encodes a single value, with the 32 bytes padding of the elements of arrays when padded is set
+func encodePacked(v reflect.Value, t *Type, padded bool) ([]byte, error) {
+	if v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+
+	switch t.kind {
+	case KindSlice, KindArray:
+		if v.Kind() != reflect.Array && v.Kind() != reflect.Slice {
+			return nil, encodeErr(v, t.kind.String())
+		}
+		if t.kind == KindArray && t.size != v.Len() {
+			return nil, fmt.Errorf("array len incompatible")
+		}
+		if t.elem.isDynamicType() && t.elem.kind != KindSlice && t.elem.kind != KindArray {
+			return nil, fmt.Errorf("packed encoding of arrays of %s is not supported", t.elem.String())
+		}
+
+		var ret []byte
+		for i := 0; i < v.Len(); i++ {
+			elem, err := encodePacked(v.Index(i), t.elem, true)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, elem...)
+		}
+		return ret, nil
+
+	case KindTuple:
+		return nil, fmt.Errorf("packed encoding of nested tuples is not supported")
+
+	case KindString:
+		if v.Kind() != reflect.String {
+			return nil, encodeErr(v, "string")
+		}
+		return []byte(v.String()), nil
+
+	case KindBytes:
+		if v.Kind() == reflect.String {
+			return decodeHex(v.String())
+		}
+		if v.Kind() == reflect.Array {
+			v = convertArrayToBytes(v)
+		}
+		if v.Kind() != reflect.Slice || v.Type().Elem().Kind() != reflect.Uint8 {
+			return nil, encodeErr(v, "bytes")
+		}
+		return v.Bytes(), nil
+	}
+
+	// Static types are encoded as a 32 bytes word which is cut down to their size
+	word, err := encode(v, t)
+	if err != nil {
+		return nil, err
+	}
+	if padded {
+		return word, nil
+	}
+
+	switch t.kind {
+	case KindFixedBytes, KindFunction:
+		for _, b := range word[t.size:] {
+			if b != 0 {
+				return nil, fmt.Errorf("value is too long for %s", t.String())
+			}
+		}
+		return word[:t.size], nil
+
+	case KindInt, KindUInt:
+		size := t.size / 8
+		extension := byte(0)
+		if t.kind == KindInt && word[32-size]&0x80 != 0 {
+			extension = 0xff
+		}
+		for _, b := range word[:32-size] {
+			if b != extension {
+				return nil, fmt.Errorf("value overflows %s", t.String())
+			}
+		}
+		return word[32-size:], nil
+
+	case KindAddress:
+		return word[12:], nil
+
+	case KindBool:
+		return word[31:], nil
+
+	default:
+		return nil, fmt.Errorf("encoding not available for type '%s'", t.kind)
+	}
+}
@@ -0,0 +1,104 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitEncodePacked(t *testing.T) {
+	t.Parallel()
+
+	// The example of the Solidity documentation
+	typ, err := NewType("tuple(int16,bytes1,uint16,string)")
+	require.NoError(t, err)
+	packed, err := EncodePacked([]interface{}{int16(-1), [1]byte{0x42}, uint16(3), "Hello, world!"}, typ)
+	require.NoError(t, err)
+	assert.Equal(t, "ffff42000348656c6c6f2c20776f726c6421", hex.EncodeToString(packed))
+
+	tests := []struct {
+		typ      string
+		value    interface{}
+		expected string
+	}{
+		{"bool", true, "01"},
+		{"int8", int8(-128), "80"},
+		{"uint24", big.NewInt(0x010203), "010203"},
+		{"int256", big.NewInt(-2), "fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe"},
+		{"address", "0x000000000000000000000000000000000000dEaD", "000000000000000000000000000000000000dead"},
+		{"bytes", []byte{1, 2, 3}, "010203"},
+		{"bytes", "0x0a0b", "0a0b"},
+		{"bytes4", [4]byte{1, 2, 3, 4}, "01020304"},
+		{"string", "", ""},
+		{"uint8[]", []uint8{1, 2}, "0000000000000000000000000000000000000000000000000000000000000001" +
+			"0000000000000000000000000000000000000000000000000000000000000002"},
+		{"bytes2[1]", [1][2]byte{{0xab, 0xcd}}, "abcd000000000000000000000000000000000000000000000000000000000000"},
+		{"bool[][]", [][]bool{{true}, {}}, "0000000000000000000000000000000000000000000000000000000000000001"},
+	}
+
+	for _, test := range tests {
+		typ, err := NewType(test.typ)
+		require.NoError(t, err)
+		packed, err := typ.EncodePacked(test.value)
+		require.NoError(t, err, test.typ)
+		assert.Equal(t, test.expected, hex.EncodeToString(packed), test.typ)
+	}
+
+	failing := []struct {
+		typ   string
+		value interface{}
+	}{
+		{"uint8", big.NewInt(256)},
+		{"uint16", big.NewInt(-1)},
+		{"int8", big.NewInt(-129)},
+		{"int8", big.NewInt(128)},
+		{"bytes2", []byte{1, 2, 3}},
+		{"string[]", []string{"a"}},
+		{"tuple(tuple(uint8))", []interface{}{[]interface{}{uint8(1)}}},
+		{"tuple(uint8,uint8)", []interface{}{uint8(1)}},
+	}
+
+	for _, test := range failing {
+		typ, err := NewType(test.typ)
+		require.NoError(t, err)
+		_, err = typ.EncodePacked(test.value)
+		assert.Error(t, err, test.typ)
+	}
+}
+
+func TestUnitSolidityPackedKeccak256(t *testing.T) {
+	t.Parallel()
+
+	owner := BytesToAddress([]byte{0xde, 0xad})
+	hash, err := SolidityPackedKeccak256([]string{"address", "uint256", "string"}, []interface{}{owner, big.NewInt(7), "x"})
+	require.NoError(t, err)
+
+	expected := Keccak256Hash(append(append(owner.Bytes(), leftPad([]byte{7}, 32)...), 'x'))
+	assert.Equal(t, expected, hash)
+
+	// keccak256(abi.encodePacked("hello"))
+	hash, err = SolidityPackedKeccak256([]string{"string"}, []interface{}{"hello"})
+	require.NoError(t, err)
+	assert.Equal(t, "1c8aff950685c2ed4bc3174f3472287b56d9517b9c948127319a09a7a36deac8", hex.EncodeToString(hash[:]))
+
+	// Struct fields are matched by name
+	typ, err := NewType("tuple(address owner, uint64 nonce)")
+	require.NoError(t, err)
+	packed, err := EncodePacked(struct {
+		Owner Address
+		Nonce uint64
+	}{owner, 1}, typ)
+	require.NoError(t, err)
+	assert.Equal(t, append(owner.Bytes(), 0, 0, 0, 0, 0, 0, 0, 1), packed)
+
+	_, err = SolidityPackedKeccak256([]string{"fixed"}, []interface{}{1})
+	require.Error(t, err)
+}
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package hiero
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// DecodedCall is the method and the arguments of a contract call decoded with ABI.DecodeCalldata
+type DecodedCall struct {
+	Method *Method
+	// Args are the decoded arguments keyed by name, or by position when unnamed
+	Args map[string]interface{}
+}
+
+// DecodeCalldata matches the 4 bytes selector of the calldata against the methods of the ABI and decodes the
+// arguments of the call
+func (a *ABI) DecodeCalldata(data []byte) (*DecodedCall, error) {
+	method := a._MethodByID(data)
+	if method == nil {
+		if len(data) < 4 {
+			return nil, fmt.Errorf("calldata is too short for a selector")
+		}
+		return nil, fmt.Errorf("no method of the abi matches the selector 0x%x", data[:4])
+	}
+
+	args, err := Decode(method.Inputs, data[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	return &DecodedCall{Method: method, Args: args.(map[string]interface{})}, nil
+}
+
+// String renders the call in a Solidity like form, eg. transfer(to: 0x5B38...dC4, amount: 1000)
+func (c *DecodedCall) String() string {
+	return c.Method.Name + c.Method.Inputs.FormatValue(c.Args)
+}
+
+// FormatValue renders a value of this type, like the ones returned by Decode, in a Solidity like form: numbers in
+// decimal, addresses checksummed, bytes in hex, strings quoted, arrays in brackets and tuples in parentheses with the
+// names of their elements.
+func (t *Type) FormatValue(v interface{}) string {
+	return formatValue(reflect.ValueOf(v), t)
+}
+
+func formatValue(v reflect.Value, t *Type) string {
+	if v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return "<nil>"
+	}
+
+	switch t.kind {
+	case KindTuple:
+		if v.Kind() == reflect.Ptr {
+			v = v.Elem()
+		}
+		if v.Kind() == reflect.Struct {
+			v, _ = mapFromStruct(v)
+		}
+
+		elems := make([]string, 0, len(t.tuple))
+		for i, elem := range t.tuple {
+			var value reflect.Value
+			switch v.Kind() {
+			case reflect.Map:
+				name := elem.Name
+				if name == "" {
+					name = strconv.Itoa(i)
+				}
+				value = v.MapIndex(reflect.ValueOf(name))
+			case reflect.Slice, reflect.Array:
+				if i < v.Len() {
+					value = v.Index(i)
+				}
+			}
+
+			formatted := formatValue(value, elem.Elem)
+			if elem.Name != "" {
+				formatted = elem.Name + ": " + formatted
+			}
+			elems = append(elems, formatted)
+		}
+		return "(" + strings.Join(elems, ", ") + ")"
+
+	case KindSlice, KindArray:
+		if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+			break
+		}
+		elems := make([]string, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			elems = append(elems, formatValue(v.Index(i), t.elem))
+		}
+		return "[" + strings.Join(elems, ", ") + "]"
+
+	case KindAddress:
+		if v.Kind() == reflect.Array && v.Len() == 20 {
+			return BytesToAddress(convertArrayToBytes(v).Bytes()).String()
+		}
+
+	case KindString:
+		if v.Kind() == reflect.String {
+			return strconv.Quote(v.String())
+		}
+
+	case KindBytes, KindFixedBytes, KindFunction:
+		if v.Kind() == reflect.Array {
+			v = convertArrayToBytes(v)
+		}
+		if v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8 {
+			return "0x" + hex.EncodeToString(v.Bytes())
+		}
+	}
+
+	return fmt.Sprint(v.Interface())
+}
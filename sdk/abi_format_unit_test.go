@@ -0,0 +1,87 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const formatTestABI = `[
+	{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[
+		{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"submit","stateMutability":"nonpayable","inputs":[
+		{"name":"order","type":"tuple","components":[
+			{"name":"id","type":"uint64"},{"name":"memo","type":"string"},{"name":"tags","type":"bytes4[]"}]},
+		{"name":"","type":"bool"},{"name":"data","type":"bytes"}],"outputs":[]}
+]`
+
+func TestUnitABIDecodeCalldata(t *testing.T) {
+	t.Parallel()
+
+	abi, err := NewABI(formatTestABI)
+	require.NoError(t, err)
+
+	to := BytesToAddress([]byte{0x5b, 0x38})
+	data, err := abi.GetMethod("transfer").Encode([]interface{}{to, big.NewInt(1000)})
+	require.NoError(t, err)
+
+	call, err := abi.DecodeCalldata(data)
+	require.NoError(t, err)
+	assert.Equal(t, abi.GetMethod("transfer"), call.Method)
+	assert.Equal(t, to, call.Args["to"])
+	assert.Equal(t, big.NewInt(1000), call.Args["amount"])
+	assert.Equal(t, "transfer(to: "+to.String()+", amount: 1000)", call.String())
+
+	order := map[string]interface{}{"id": uint64(7), "memo": "say \"hi\"", "tags": [][4]byte{{1, 2, 3, 4}}}
+	data, err = abi.GetMethod("submit").Encode([]interface{}{order, true, []byte{0xff}})
+	require.NoError(t, err)
+
+	call, err = abi.DecodeCalldata(data)
+	require.NoError(t, err)
+	assert.Equal(t, true, call.Args["1"])
+	assert.Equal(t, `submit(order: (id: 7, memo: "say \"hi\"", tags: [0x01020304]), true, data: 0xff)`, call.String())
+
+	_, err = abi.DecodeCalldata([]byte{1, 2, 3, 4})
+	require.ErrorContains(t, err, "0x01020304")
+	_, err = abi.DecodeCalldata([]byte{1})
+	require.Error(t, err)
+	_, err = abi.DecodeCalldata(abi.GetMethod("transfer").ID())
+	require.Error(t, err)
+}
+
+func TestUnitABIFormatValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		typ      string
+		value    interface{}
+		expected string
+	}{
+		{"uint256", big.NewInt(-5), "-5"},
+		{"int8", int8(-5), "-5"},
+		{"bool", false, "false"},
+		{"string", "a\nb", `"a\nb"`},
+		{"bytes32", [32]byte{31: 1}, "0x0000000000000000000000000000000000000000000000000000000000000001"},
+		{"address", [20]byte{19: 1}, "0x0000000000000000000000000000000000000001"},
+		{"uint8[2]", [2]uint8{1, 2}, "[1, 2]"},
+		{"tuple(uint8,string)", []interface{}{uint8(1), "x"}, `(1, "x")`},
+		{"tuple(uint8 a,bool b)", struct {
+			A uint8
+			B bool
+		}{1, true}, "(a: 1, b: true)"},
+		{"uint8", nil, "<nil>"},
+	}
+
+	for _, test := range tests {
+		typ, err := NewType(test.typ)
+		require.NoError(t, err)
+		assert.Equal(t, test.expected, typ.FormatValue(test.value), test.typ)
+	}
+}
@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package hiero
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+// Sig returns the signature of the event
+func (e *Event) Sig() string {
+	return buildSignature(e.Name, e.Inputs)
+}
+
+// ID returns the id of the event, which is the first topic of its logs unless it is anonymous
+func (e *Event) ID() Hash {
+	k := acquireKeccak()
+	k.Write([]byte(e.Sig()))
+	var dst Hash
+	copy(dst[:], k.Sum(nil))
+	releaseKeccak(k)
+	return dst
+}
+
+// Match reports whether the log was emitted by this event, by comparing its first topic with the id of the event.
+// Anonymous events have no id and never match.
+func (e *Event) Match(log ContractLogInfo) bool {
+	if e.Anonymous || len(log.Topics) == 0 {
+		return false
+	}
+	id := e.ID()
+	return bytes.Equal(log.Topics[0], id[:])
+}
+
+// ParseLog decodes the parameters of the event from a log. The indexed parameters are decoded from the topics and
+// the others from the data. Indexed parameters of a dynamic or composite type (string, bytes, arrays and tuples)
+// are only logged as the keccak256 hash of their value, which is returned as a Hash. Unnamed parameters are keyed
+// by their position.
+func (e *Event) ParseLog(log ContractLogInfo) (map[string]interface{}, error) {
+	topics := log.Topics
+	if !e.Anonymous {
+		if !e.Match(log) {
+			return nil, fmt.Errorf("log is not an event %s", e.Sig())
+		}
+		topics = topics[1:]
+	}
+
+	res := make(map[string]interface{})
+	var nonIndexed []*TupleElem
+	for indx, arg := range e.Inputs.tuple {
+		name := arg.Name
+		if name == "" {
+			name = strconv.Itoa(indx)
+		}
+
+		if !arg.Indexed {
+			nonIndexed = append(nonIndexed, &TupleElem{Name: name, Elem: arg.Elem})
+			continue
+		}
+
+		if len(topics) == 0 {
+			return nil, fmt.Errorf("missing topic for indexed parameter %s of event %s", name, e.Sig())
+		}
+		topic := topics[0]
+		topics = topics[1:]
+		if len(topic) != 32 {
+			return nil, fmt.Errorf("incorrect topic length %d", len(topic))
+		}
+
+		if _IsHashedTopicType(arg.Elem) {
+			var hash Hash
+			copy(hash[:], topic)
+			res[name] = hash
+			continue
+		}
+
+		val, _, err := decode(arg.Elem, topic)
+		if err != nil {
+			return nil, err
+		}
+		res[name] = val
+	}
+
+	if len(nonIndexed) > 0 {
+		val, err := Decode(NewTupleType(nonIndexed), log.Data)
+		if err != nil {
+			return nil, err
+		}
+		for name, v := range val.(map[string]interface{}) {
+			res[name] = v
+		}
+	}
+
+	return res, nil
+}
+
+// ParseLogStruct decodes the parameters of the event from a log to a struct, like ParseLog
+func (e *Event) ParseLogStruct(log ContractLogInfo, out interface{}) error {
+	val, err := e.ParseLog(log)
+	if err != nil {
+		return err
+	}
+	return _DecodeABIMap(val, out)
+}
+
+// GetEventByID returns the event whose id is the given first topic of a log
+func (a *ABI) GetEventByID(id []byte) *Event {
+	for _, e := range a.Events {
+		eventID := e.ID()
+		if !e.Anonymous && bytes.Equal(eventID[:], id) {
+			return e
+		}
+	}
+	return nil
+}
+
+// ParseLog finds the event of the ABI which emitted the log, by its first topic, and decodes its parameters
+func (a *ABI) ParseLog(log ContractLogInfo) (*Event, map[string]interface{}, error) {
+	if len(log.Topics) == 0 {
+		return nil, nil, fmt.Errorf("log has no topics")
+	}
+	e := a.GetEventByID(log.Topics[0])
+	if e == nil {
+		return nil, nil, fmt.Errorf("no event of the abi matches topic 0x%x", log.Topics[0])
+	}
+	val, err := e.ParseLog(log)
+	if err != nil {
+		return nil, nil, err
+	}
+	return e, val, nil
+}
+
+// DecodedLog is a log decoded with the event of an ABI
+type DecodedLog struct {
+	Event  *Event
+	Values map[string]interface{}
+	Log    ContractLogInfo
+}
+
+// Decode decodes the parameters of the event to a struct
+func (l DecodedLog) Decode(out interface{}) error {
+	return _DecodeABIMap(l.Values, out)
+}
+
+// _IsHashedTopicType reports whether an indexed parameter of this type is logged as the hash of its value
+func _IsHashedTopicType(t *Type) bool {
+	switch t.kind {
+	case KindString, KindBytes, KindSlice, KindArray, KindTuple:
+		return true
+	default:
+		return false
+	}
+}
+
+func _DecodeABIMap(val interface{}, out interface{}) error {
+	dc := &mapstructure.DecoderConfig{
+		Result:           out,
+		WeaklyTypedInput: true,
+		TagName:          "abi",
+	}
+	ms, err := mapstructure.NewDecoder(dc)
+	if err != nil {
+		return err
+	}
+	return ms.Decode(val)
+}
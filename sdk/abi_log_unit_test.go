@@ -0,0 +1,121 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const logTestABI = `[
+	{"type":"event","name":"Transfer","inputs":[
+		{"name":"from","type":"address","indexed":true},
+		{"name":"to","type":"address","indexed":true},
+		{"name":"value","type":"uint256","indexed":false}]},
+	{"type":"event","name":"NameSet","inputs":[
+		{"name":"name","type":"string","indexed":true},
+		{"name":"","type":"string","indexed":false},
+		{"name":"count","type":"uint8","indexed":false}]}
+]`
+
+func _LogTestWord(value string) []byte {
+	word := make([]byte, 32)
+	data, _ := hex.DecodeString(value)
+	copy(word[32-len(data):], data)
+	return word
+}
+
+func TestUnitAbiParseLog(t *testing.T) {
+	t.Parallel()
+
+	abi, err := NewABI(logTestABI)
+	require.NoError(t, err)
+
+	transfer := abi.Events["Transfer"]
+	assert.Equal(t, "Transfer(address,address,uint256)", transfer.Sig())
+	id := transfer.ID()
+	assert.Equal(t, "ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef", hex.EncodeToString(id[:]))
+
+	log := ContractLogInfo{
+		Topics: [][]byte{id[:], _LogTestWord("0405"), _LogTestWord("0406")},
+		Data:   _LogTestWord("64"),
+	}
+	values, err := transfer.ParseLog(log)
+	require.NoError(t, err)
+	assert.Equal(t, "0x0000000000000000000000000000000000000405", values["from"].(Address).String())
+	assert.Equal(t, "0x0000000000000000000000000000000000000406", values["to"].(Address).String())
+	assert.Equal(t, big.NewInt(100), values["value"])
+
+	var out struct {
+		From  Address  `abi:"from"`
+		Value *big.Int `abi:"value"`
+	}
+	require.NoError(t, transfer.ParseLogStruct(log, &out))
+	assert.Equal(t, big.NewInt(100), out.Value)
+	assert.Equal(t, values["from"], out.From)
+
+	event, values, err := abi.ParseLog(log)
+	require.NoError(t, err)
+	assert.Same(t, transfer, event)
+	assert.Len(t, values, 3)
+
+	// Indexed strings are only logged as their hash
+	nameSet := abi.Events["NameSet"]
+	nameSetID := nameSet.ID()
+	nameHash := _LogTestWord("1234")
+	data := append(_LogTestWord("40"), _LogTestWord("07")...)
+	data = append(data, _LogTestWord("02")...)
+	data = append(data, []byte("hi")...)
+	data = append(data, make([]byte, 30)...)
+	values, err = nameSet.ParseLog(ContractLogInfo{Topics: [][]byte{nameSetID[:], nameHash}, Data: data})
+	require.NoError(t, err)
+	var expectedHash Hash
+	copy(expectedHash[:], nameHash)
+	assert.Equal(t, expectedHash, values["name"])
+	assert.Equal(t, "hi", values["1"])
+	assert.Equal(t, uint8(7), values["count"])
+
+	_, err = nameSet.ParseLog(log)
+	require.Error(t, err)
+	_, _, err = abi.ParseLog(ContractLogInfo{Topics: [][]byte{_LogTestWord("01")}})
+	require.Error(t, err)
+	_, err = transfer.ParseLog(ContractLogInfo{Topics: [][]byte{id[:]}, Data: log.Data})
+	require.Error(t, err)
+}
+
+func TestUnitContractFunctionResultDecodeLogs(t *testing.T) {
+	t.Parallel()
+
+	abi, err := NewABI(logTestABI)
+	require.NoError(t, err)
+	id := abi.Events["Transfer"].ID()
+
+	result := ContractFunctionResult{
+		LogInfo: []ContractLogInfo{
+			{Topics: [][]byte{id[:], _LogTestWord("0405"), _LogTestWord("0406")}, Data: _LogTestWord("64")},
+			{Topics: [][]byte{_LogTestWord("01")}, Data: _LogTestWord("01")},
+			{Data: _LogTestWord("01")},
+		},
+	}
+
+	logs, err := result.DecodeLogs(abi)
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	assert.Equal(t, "Transfer", logs[0].Event.Name)
+
+	var transfer struct {
+		From  Address
+		To    Address
+		Value *big.Int
+	}
+	require.NoError(t, logs[0].Decode(&transfer))
+	assert.Equal(t, big.NewInt(100), transfer.Value)
+	assert.Equal(t, "0x0000000000000000000000000000000000000406", transfer.To.String())
+}
@@ -40,3 +40,20 @@ func (balance *AccountBalance) _ToProtobuf() *services.CryptoGetAccountBalanceRe
 		Balance: uint64(balance.Hbars.AsTinybar()),
 	}
 }
+
+// MarshalJSON returns the protobuf JSON mapping of the proto.CryptoGetAccountBalanceResponse message for the AccountBalance.
+func (balance AccountBalance) MarshalJSON() ([]byte, error) {
+	return _ProtobufToJSON(balance._ToProtobuf())
+}
+
+// UnmarshalJSON decodes a AccountBalance from the protobuf JSON mapping of the proto.CryptoGetAccountBalanceResponse message.
+func (balance *AccountBalance) UnmarshalJSON(data []byte) error {
+	pb := services.CryptoGetAccountBalanceResponse{}
+	if err := _ProtobufFromJSON(data, &pb); err != nil {
+		return err
+	}
+
+	*balance = _AccountBalanceFromProtobuf(&pb)
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/sdk/mirror"
+	"github.com/pkg/errors"
+)
+
+// AccountBalanceAtQuery gets the hbar and token balances of an account at a past consensus timestamp from the
+// mirror node. The mirror node takes balance snapshots periodically, so the balances are the ones of the latest
+// snapshot at or before the timestamp.
+type AccountBalanceAtQuery struct {
+	accountID *AccountID
+	timestamp *time.Time
+}
+
+// NewAccountBalanceAtQuery creates an AccountBalanceAtQuery which gets the balances of an account from the
+// mirror node.
+func NewAccountBalanceAtQuery() *AccountBalanceAtQuery {
+	return &AccountBalanceAtQuery{}
+}
+
+// SetAccountID sets the account whose balances are returned.
+func (query *AccountBalanceAtQuery) SetAccountID(accountID AccountID) *AccountBalanceAtQuery {
+	query.accountID = &accountID
+	return query
+}
+
+// GetAccountID returns the account whose balances are returned.
+func (query *AccountBalanceAtQuery) GetAccountID() AccountID {
+	if query.accountID == nil {
+		return AccountID{}
+	}
+
+	return *query.accountID
+}
+
+// SetTimestamp sets the consensus timestamp of the balances. The latest balances are returned if it isn't set.
+func (query *AccountBalanceAtQuery) SetTimestamp(timestamp time.Time) *AccountBalanceAtQuery {
+	query.timestamp = &timestamp
+	return query
+}
+
+// GetTimestamp returns the consensus timestamp of the balances.
+func (query *AccountBalanceAtQuery) GetTimestamp() time.Time {
+	if query.timestamp == nil {
+		return time.Time{}
+	}
+
+	return *query.timestamp
+}
+
+// Execute gets the balances from the mirror node, following its pages. The decimals of the tokens are looked up to
+// fill AccountBalance.TokenDecimals.
+func (query *AccountBalanceAtQuery) Execute(client *Client) (AccountBalance, error) {
+	if query.accountID == nil {
+		return AccountBalance{}, errors.New("account ID is required")
+	}
+
+	var balances []mirror.AccountBalance
+	err := client._CallMirrorRest("5551", func(mirrorClient *mirror.Client) (err error) {
+		request := mirrorClient.ListBalances().Filter("account.id", mirror.OperatorEq, query.accountID.String())
+		if query.timestamp != nil {
+			request.Timestamp(mirror.OperatorLte, mirror.TimestampFromTime(*query.timestamp))
+		}
+		balances, err = request.Iterate(context.Background()).Collect()
+		return err
+	})
+	if err != nil {
+		return AccountBalance{}, err
+	}
+	if len(balances) == 0 {
+		return AccountBalance{}, errors.Errorf("no balance found for account %s", query.accountID.String())
+	}
+
+	balance := AccountBalance{
+		Hbars:         HbarFromTinybar(balances[0].Balance),
+		Token:         make(map[TokenID]uint64),
+		Tokens:        TokenBalanceMap{balances: make(map[string]uint64)},
+		TokenDecimals: TokenDecimalMap{decimals: make(map[string]uint64)},
+	}
+
+	decimals, err := _MirrorAccountTokenDecimals(client, *query.accountID)
+	if err != nil {
+		return AccountBalance{}, err
+	}
+
+	for _, accountBalance := range balances {
+		for _, tokenBalance := range accountBalance.Tokens {
+			tokenID, err := TokenIDFromString(tokenBalance.TokenID)
+			if err != nil {
+				return AccountBalance{}, err
+			}
+
+			// Tokens the account was dissociated from since the timestamp are looked up on their own
+			tokenDecimals, ok := decimals[tokenID.String()]
+			if !ok {
+				if tokenDecimals, err = _MirrorTokenDecimals(client, tokenID); err != nil {
+					return AccountBalance{}, err
+				}
+				decimals[tokenID.String()] = tokenDecimals
+			}
+
+			balance.Token[tokenID] = uint64(tokenBalance.Balance)
+			balance.Tokens.balances[tokenID.String()] = uint64(tokenBalance.Balance)
+			balance.TokenDecimals.decimals[tokenID.String()] = tokenDecimals
+		}
+	}
+
+	return balance, nil
+}
+
+// _MirrorAccountTokenDecimals returns the decimals of the tokens associated with an account, keyed by token ID,
+// from the pages of its token relationships.
+func _MirrorAccountTokenDecimals(client *Client, accountID AccountID) (map[string]uint64, error) {
+	var relationships []mirror.TokenRelationship
+	err := client._CallMirrorRest("5551", func(mirrorClient *mirror.Client) (err error) {
+		relationships, err = mirrorClient.ListAccountTokens(accountID.String()).Iterate(context.Background()).Collect()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	decimals := make(map[string]uint64, len(relationships))
+	for _, relationship := range relationships {
+		decimals[relationship.TokenID] = uint64(relationship.Decimals)
+	}
+
+	return decimals, nil
+}
+
+// _MirrorTokenDecimals returns the decimals of a token from the mirror node.
+func _MirrorTokenDecimals(client *Client, tokenID TokenID) (uint64, error) {
+	var token *mirror.Token
+	err := client._CallMirrorRest("5551", func(mirrorClient *mirror.Client) (err error) {
+		token, err = mirrorClient.GetToken(context.Background(), tokenID.String())
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseUint(token.Decimals, 10, 32)
+}
@@ -0,0 +1,65 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitAccountBalanceAtQuery(t *testing.T) {
+	t.Parallel()
+
+	var tokenRequests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/balances":
+			if r.URL.Query().Get("page") == "2" {
+				_, _ = w.Write([]byte(`{"balances":[
+					{"account":"0.0.5","balance":1500,"tokens":[{"token_id":"0.0.8","balance":7}]}],"links":{"next":null}}`))
+				return
+			}
+			assert.Equal(t, "eq:0.0.5", r.URL.Query().Get("account.id"))
+			assert.Equal(t, "lte:1700000000.000000000", r.URL.Query().Get("timestamp"))
+			_, _ = w.Write([]byte(`{"timestamp":"1699999100.000000000","balances":[
+				{"account":"0.0.5","balance":1500,"tokens":[{"token_id":"0.0.7","balance":12345}]}],
+				"links":{"next":"/api/v1/balances?account.id=eq:0.0.5&page=2"}}`))
+		case "/api/v1/accounts/0.0.5/tokens":
+			_, _ = w.Write([]byte(`{"tokens":[{"token_id":"0.0.7","decimals":2}],"links":{"next":null}}`))
+		case "/api/v1/tokens/0.0.8":
+			atomic.AddInt32(&tokenRequests, 1)
+			_, _ = w.Write([]byte(`{"token_id":"0.0.8","decimals":"3"}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := _NewMirrorTestClient(t, server)
+	balance, err := NewAccountBalanceAtQuery().
+		SetAccountID(AccountID{Account: 5}).
+		SetTimestamp(time.Unix(1700000000, 0)).
+		Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, HbarFromTinybar(1500), balance.Hbars)
+	assert.Equal(t, uint64(12345), balance.Tokens.Get(TokenID{Token: 7}))
+	assert.Equal(t, uint64(2), balance.TokenDecimals.Get(TokenID{Token: 7}))
+
+	// The tokens of the following pages are included, and only the dissociated token is looked up on its own
+	assert.Equal(t, uint64(7), balance.Tokens.Get(TokenID{Token: 8}))
+	assert.Equal(t, uint64(3), balance.TokenDecimals.Get(TokenID{Token: 8}))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&tokenRequests))
+
+	_, err = NewAccountBalanceAtQuery().Execute(client)
+	require.Error(t, err)
+}
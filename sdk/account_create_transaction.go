@@ -305,6 +305,10 @@ func (tx AccountCreateTransaction) getName() string {
 	return "AccountCreateTransaction"
 }
 
+func (tx AccountCreateTransaction) validateBody() []ErrTransactionValidation {
+	return _ValidateMemo("account memo", tx.memo)
+}
+
 func (tx AccountCreateTransaction) validateNetworkOnIDs(client *Client) error {
 	if client == nil || !client.autoValidateChecksums {
 		return nil
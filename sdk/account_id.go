@@ -1,16 +1,16 @@
 package hiero
 
 import (
+	"context"
 	"encoding/hex"
-	"encoding/json"
 	"fmt"
-	"net/http"
 	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/hiero-ledger/hiero-sdk-go/v2/sdk/mirror"
 	protobuf "google.golang.org/protobuf/proto"
 )
 
@@ -214,6 +214,11 @@ func (id AccountID) _ToProtobuf() *services.AccountID {
 	return resultID
 }
 
+// MarshalJSON implements the encoding.JSON interface.
+func (id AccountID) MarshalJSON() ([]byte, error) {
+	return _StringToJSON(id.String())
+}
+
 // UnmarshalJSON implements the encoding.JSON interface.
 func (id *AccountID) UnmarshalJSON(data []byte) error {
 	accountID, err := AccountIDFromString(strings.Replace(string(data), "\"", "", 2))
@@ -324,62 +329,25 @@ const (
 	EvmAddress
 )
 
-func (id *AccountID) _MirrorNodeRequest(client *Client, populateType string) (map[string]interface{}, error) {
-	if client.mirrorNetwork == nil || len(client.GetMirrorNetwork()) == 0 {
-		return nil, errors.New("mirror node is not set")
-	}
-
-	mirrorUrl := client.GetMirrorNetwork()[0]
-	index := strings.Index(mirrorUrl, ":")
-	if index == -1 {
-		return nil, errors.New("invalid mirrorUrl format")
-	}
-	mirrorUrl = mirrorUrl[:index]
-
-	var url string
-	protocol := "https"
-	port := ""
-
-	if client.GetLedgerID().String() == "" {
-		protocol = "http"
-		port = ":5551"
-	}
-
-	if populateType == "account" {
-		url = fmt.Sprintf("%s://%s%s/api/v1/accounts/%s", protocol, mirrorUrl, port, hex.EncodeToString(*id.AliasEvmAddress))
-	} else {
-		url = fmt.Sprintf("%s://%s%s/api/v1/accounts/%s", protocol, mirrorUrl, port, id.String())
-	}
-
-	resp, err := http.Get(url) // #nosec
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	var result map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, err
-	}
-
-	return result, nil
-}
-
 // PopulateAccount gets the actual `Account` field of the `AccountId` from the Mirror Node.
 // Should be used after generating `AccountId.FromEvmAddress()` because it sets the `Account` field to `0`
 // automatically since there is no connection between the `Account` and the `evmAddress`
 func (id *AccountID) PopulateAccount(client *Client) error {
-	result, err := id._MirrorNodeRequest(client, "account")
-	if err != nil {
-		return err
+	var evmAddress []byte
+	if id.AliasEvmAddress != nil {
+		evmAddress = *id.AliasEvmAddress
 	}
 
-	mirrorAccountId, ok := result["account"].(string)
-	if !ok {
-		return errors.New("unexpected response format")
+	var account *mirror.Account
+	err := client._CallMirrorRest("5551", func(mirrorClient *mirror.Client) (err error) {
+		account, err = mirrorClient.GetAccount(context.Background(), hex.EncodeToString(evmAddress))
+		return err
+	})
+	if err != nil {
+		return err
 	}
 
-	numStr := mirrorAccountId[strings.LastIndex(mirrorAccountId, ".")+1:]
+	numStr := account.Account[strings.LastIndex(account.Account, ".")+1:]
 	num, err := strconv.ParseInt(numStr, 10, 64)
 	if err != nil {
 		return err
@@ -390,17 +358,19 @@ func (id *AccountID) PopulateAccount(client *Client) error {
 
 // PopulateEvmAddress gets the actual `AliasEvmAddress` field of the `AccountId` from the Mirror Node.
 func (id *AccountID) PopulateEvmAddress(client *Client) error {
-	result, err := id._MirrorNodeRequest(client, "evmAddress")
+	var account *mirror.Account
+	err := client._CallMirrorRest("5551", func(mirrorClient *mirror.Client) (err error) {
+		account, err = mirrorClient.GetAccount(context.Background(), id.String())
+		return err
+	})
 	if err != nil {
 		return err
 	}
-
-	mirrorEvmAddress, ok := result["evm_address"].(string)
-	if !ok {
+	if account.EvmAddress == "" {
 		return errors.New("unexpected response format")
 	}
 
-	mirrorEvmAddress = strings.TrimPrefix(mirrorEvmAddress, "0x")
+	mirrorEvmAddress := strings.TrimPrefix(account.EvmAddress, "0x")
 	asd, err := hex.DecodeString(mirrorEvmAddress)
 	if err != nil {
 		return err
@@ -163,6 +163,28 @@ func (info AccountInfo) ToBytes() []byte {
 	return data
 }
 
+// MarshalJSON returns the protobuf JSON mapping of the proto.CryptoGetInfoResponse.AccountInfo message for the AccountInfo.
+func (info AccountInfo) MarshalJSON() ([]byte, error) {
+	return _ProtobufToJSON(info._ToProtobuf())
+}
+
+// UnmarshalJSON decodes a AccountInfo from the protobuf JSON mapping of the proto.CryptoGetInfoResponse.AccountInfo message.
+func (info *AccountInfo) UnmarshalJSON(data []byte) error {
+	pb := services.CryptoGetInfoResponse_AccountInfo{}
+	if err := _ProtobufFromJSON(data, &pb); err != nil {
+		return err
+	}
+
+	result, err := _AccountInfoFromProtobuf(&pb)
+	if err != nil {
+		return err
+	}
+
+	*info = result
+
+	return nil
+}
+
 // AccountInfoFromBytes returns an AccountInfo from byte array
 func AccountInfoFromBytes(data []byte) (AccountInfo, error) {
 	if data == nil {
@@ -0,0 +1,139 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"github.com/hiero-ledger/hiero-sdk-go/v2/sdk/mirror"
+	"github.com/pkg/errors"
+)
+
+// AccountNftsQuery lists the NFTs owned by an account from the mirror node.
+type AccountNftsQuery struct {
+	mirrorNftQuery
+}
+
+// NewAccountNftsQuery creates an AccountNftsQuery which lists the NFTs owned by an account.
+func NewAccountNftsQuery() *AccountNftsQuery {
+	return &AccountNftsQuery{}
+}
+
+// SetAccountID sets the account whose NFTs are listed.
+func (query *AccountNftsQuery) SetAccountID(accountID AccountID) *AccountNftsQuery {
+	query.accountID = &accountID
+	return query
+}
+
+// GetAccountID returns the account whose NFTs are listed.
+func (query *AccountNftsQuery) GetAccountID() AccountID {
+	if query.accountID == nil {
+		return AccountID{}
+	}
+
+	return *query.accountID
+}
+
+// SetTokenID only lists the NFTs of the given token.
+func (query *AccountNftsQuery) SetTokenID(tokenID TokenID) *AccountNftsQuery {
+	query.tokenID = &tokenID
+	return query
+}
+
+// GetTokenID returns the token whose NFTs are listed.
+func (query *AccountNftsQuery) GetTokenID() TokenID {
+	if query.tokenID == nil {
+		return TokenID{}
+	}
+
+	return *query.tokenID
+}
+
+// SetSerialRange only lists the NFTs with a serial number between from and to, inclusive. It requires the token
+// to be set.
+func (query *AccountNftsQuery) SetSerialRange(from int64, to int64) *AccountNftsQuery {
+	query.serialFrom = &from
+	query.serialTo = &to
+	return query
+}
+
+// GetSerialRange returns the range of the serial numbers of the listed NFTs.
+func (query *AccountNftsQuery) GetSerialRange() (int64, int64) {
+	if query.serialFrom == nil || query.serialTo == nil {
+		return 0, 0
+	}
+
+	return *query.serialFrom, *query.serialTo
+}
+
+// SetSpenderID only lists the NFTs the given spender is approved for.
+func (query *AccountNftsQuery) SetSpenderID(spenderID AccountID) *AccountNftsQuery {
+	query.spenderID = &spenderID
+	return query
+}
+
+// GetSpenderID returns the spender of the listed NFTs.
+func (query *AccountNftsQuery) GetSpenderID() AccountID {
+	if query.spenderID == nil {
+		return AccountID{}
+	}
+
+	return *query.spenderID
+}
+
+// SetOrder sets the order of the NFTs, by token and serial number. The mirror node defaults to descending.
+func (query *AccountNftsQuery) SetOrder(order mirror.Order) *AccountNftsQuery {
+	query.order = order
+	return query
+}
+
+// GetOrder returns the order of the NFTs.
+func (query *AccountNftsQuery) GetOrder() mirror.Order {
+	return query.order
+}
+
+// SetPageSize sets the number of NFTs requested from the mirror node at once.
+func (query *AccountNftsQuery) SetPageSize(pageSize int) *AccountNftsQuery {
+	query.pageSize = pageSize
+	return query
+}
+
+// GetPageSize returns the number of NFTs requested from the mirror node at once.
+func (query *AccountNftsQuery) GetPageSize() int {
+	return query.pageSize
+}
+
+// Iterate returns an iterator over the NFTs, which fetches the pages of the mirror node as they are consumed.
+func (query *AccountNftsQuery) Iterate(client *Client) mirror.Iterator[TokenNftInfo] {
+	if err := query._Validate(); err != nil {
+		return func(yield func(TokenNftInfo, error) bool) {
+			yield(TokenNftInfo{}, err)
+		}
+	}
+
+	return query._Iterate(client, func(mirrorClient *mirror.Client) *mirror.ListRequest[mirror.Nft] {
+		request := mirrorClient.ListAccountNfts(query.accountID.String())
+		query._ApplyFilters(request)
+		if query.tokenID != nil {
+			request.Filter("token.id", mirror.OperatorEq, query.tokenID.String())
+		}
+		if query.spenderID != nil {
+			request.Filter("spender.id", mirror.OperatorEq, query.spenderID.String())
+		}
+		return request
+	}, false)
+}
+
+// Execute lists all the NFTs.
+func (query *AccountNftsQuery) Execute(client *Client) ([]TokenNftInfo, error) {
+	return query.Iterate(client).Collect()
+}
+
+func (query *AccountNftsQuery) _Validate() error {
+	if query.accountID == nil {
+		return errors.New("account ID is required")
+	}
+	if query.serialFrom != nil && query.tokenID == nil {
+		return errors.New("a serial range requires the token ID")
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/sdk/mirror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitAccountNftsQuery(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/accounts/0.0.5/nfts", r.URL.Path)
+		query := r.URL.Query()
+		if query.Get("limit") == "" {
+			_, _ = w.Write([]byte(`{"nfts":[{"account_id":"0.0.5","created_timestamp":"1700000000.000000003","metadata":"AQI=",
+				"serial_number":3,"spender":"0.0.8","token_id":"0.0.7"}],"links":{"next":null}}`))
+			return
+		}
+
+		assert.Equal(t, "eq:0.0.7", query.Get("token.id"))
+		assert.Equal(t, "eq:0.0.8", query.Get("spender.id"))
+		assert.Equal(t, []string{"gte:1", "lte:10"}, query["serialnumber"])
+		assert.Equal(t, "asc", query.Get("order"))
+		_, _ = w.Write([]byte(`{"nfts":[
+			{"account_id":"0.0.5","created_timestamp":"1700000000.000000001","metadata":"aXBmcw==","serial_number":1,"spender":"0.0.8","token_id":"0.0.7"},
+			{"account_id":"0.0.5","created_timestamp":"1700000000.000000002","metadata":"","serial_number":2,"spender":"0.0.8","token_id":"0.0.7"}],
+			"links":{"next":"/api/v1/accounts/0.0.5/nfts?order=asc&token.id=0.0.7&serialnumber=gt:2&serialnumber=lte:10"}}`))
+	}))
+	defer server.Close()
+
+	client := _NewMirrorTestClient(t, server)
+	nfts, err := NewAccountNftsQuery().
+		SetAccountID(AccountID{Account: 5}).
+		SetTokenID(TokenID{Token: 7}).
+		SetSerialRange(1, 10).
+		SetSpenderID(AccountID{Account: 8}).
+		SetOrder(mirror.OrderAsc).
+		SetPageSize(2).
+		Execute(client)
+	require.NoError(t, err)
+	require.Len(t, nfts, 3)
+	assert.Equal(t, NftID{TokenID: TokenID{Token: 7}, SerialNumber: 1}, nfts[0].NftID)
+	assert.Equal(t, AccountID{Account: 5}, nfts[0].AccountID)
+	assert.Equal(t, AccountID{Account: 8}, nfts[0].SpenderID)
+	assert.Equal(t, []byte("ipfs"), nfts[0].Metadata)
+	assert.True(t, time.Unix(1700000000, 1).Equal(nfts[0].CreationTime))
+	assert.Equal(t, []byte{1, 2}, nfts[2].Metadata)
+
+	// Stopping early doesn't fetch the following pages
+	count := 0
+	NewAccountNftsQuery().
+		SetAccountID(AccountID{Account: 5}).
+		SetTokenID(TokenID{Token: 7}).
+		SetSerialRange(1, 10).
+		SetSpenderID(AccountID{Account: 8}).
+		SetOrder(mirror.OrderAsc).
+		SetPageSize(2).
+		Iterate(client)(func(info TokenNftInfo, err error) bool {
+		require.NoError(t, err)
+		count++
+		return false
+	})
+	assert.Equal(t, 1, count)
+
+	_, err = NewAccountNftsQuery().SetAccountID(AccountID{Account: 5}).SetSerialRange(1, 2).Execute(client)
+	require.Error(t, err)
+	_, err = NewAccountNftsQuery().Execute(client)
+	require.Error(t, err)
+}
@@ -290,6 +290,10 @@ func (tx AccountUpdateTransaction) getName() string {
 	return "AccountUpdateTransaction"
 }
 
+func (tx AccountUpdateTransaction) validateBody() []ErrTransactionValidation {
+	return _ValidateMemo("account memo", tx.memo)
+}
+
 func (tx AccountUpdateTransaction) validateNetworkOnIDs(client *Client) error {
 	if client == nil || !client.autoValidateChecksums {
 		return nil
@@ -104,7 +104,8 @@ func (q *AddressBookQuery) Execute(client *Client) (NodeAddressBook, error) {
 
 	messages := make([]*services.NodeAddress, 0)
 
-	channel, err := client.mirrorNetwork._GetNextMirrorNode()._GetNetworkServiceClient()
+	node := client.mirrorNetwork._GetNextMirrorNode()
+	channel, err := node._GetNetworkServiceClient()
 	if err != nil {
 		return NodeAddressBook{}, err
 	}
@@ -122,6 +123,13 @@ func (q *AddressBookQuery) Execute(client *Client) (NodeAddressBook, error) {
 					if q.attempt < q.maxAttempts {
 						subClient = nil
 
+						// Retry on another mirror node
+						client.mirrorNetwork._IncreaseBackoff(node)
+						node = client.mirrorNetwork._GetNextMirrorNode()
+						if nextChannel, channelErr := node._GetNetworkServiceClient(); channelErr == nil {
+							channel = nextChannel
+						}
+
 						delay := math.Min(250.0*math.Pow(2.0, float64(q.attempt)), 8000)
 						time.Sleep(time.Duration(delay) * time.Millisecond)
 						q.attempt++
@@ -9,8 +9,13 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"net/url"
 	"os"
+	"strings"
 	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/sdk/mirror"
 )
 
 //go:embed addressbook/mainnet.pb
@@ -47,9 +52,15 @@ type Client struct {
 	networkUpdateContext       context.Context
 	cancelNetworkUpdate        context.CancelFunc
 	logger                     Logger
+
+	mirrorHTTPClient     *http.Client
+	mirrorHeaders        http.Header
+	mirrorRequestTimeout time.Duration
+	mirrorMaxAttempts    int
 }
 
-// TransactionSigner is a closure or function that defines how transactions will be signed
+// TransactionSigner is a closure or function that defines how transactions will be signed.
+// It is called concurrently for the bodies of different nodes unless SetMaxSigningWorkers is set to 1.
 type TransactionSigner func(message []byte) []byte
 
 type _Operator struct {
@@ -130,6 +141,10 @@ func _NewClient(network _Network, mirrorNetwork []string, ledgerId *LedgerID, sh
 		networkUpdateContext:            ctx,
 		cancelNetworkUpdate:             cancel,
 		logger:                          defaultLogger,
+		mirrorHTTPClient:                http.DefaultClient,
+		mirrorHeaders:                   http.Header{},
+		mirrorRequestTimeout:            30 * time.Second,
+		mirrorMaxAttempts:               5,
 	}
 
 	client.SetMirrorNetwork(mirrorNetwork)
@@ -503,6 +518,161 @@ func (client *Client) GetMirrorNetwork() []string {
 	return client.mirrorNetwork._GetNetwork()
 }
 
+// GetMirrorRestClient returns a client for the REST API of a healthy node of the mirror network. Networks without a
+// ledger ID, eg. a local network, serve the REST API over http on port 5551.
+func (client *Client) GetMirrorRestClient() (*mirror.Client, error) {
+	if client == nil || client.mirrorNetwork == nil || len(client.GetMirrorNetwork()) == 0 {
+		return nil, errors.New("mirror node is not set")
+	}
+
+	return client._MirrorRestClient(client.mirrorNetwork._GetNextMirrorNode(), "5551")
+}
+
+// _CallMirrorRest calls the REST API of the mirror network, moving on to the next node when a node can't be reached
+// or keeps answering with status 429 or 5xx. Failing nodes back off like consensus nodes do. localPort is the port
+// of the REST service on networks without a ledger ID, since a local network serves the contract call API separately.
+func (client *Client) _CallMirrorRest(localPort string, call func(mirrorClient *mirror.Client) error) error {
+	if client == nil || client.mirrorNetwork == nil || len(client.GetMirrorNetwork()) == 0 {
+		return errors.New("mirror node is not set")
+	}
+
+	var err error
+	for _, node := range client.mirrorNetwork._GetMirrorNodes() {
+		var mirrorClient *mirror.Client
+		if mirrorClient, err = client._MirrorRestClient(node, localPort); err != nil {
+			return err
+		}
+
+		node._InUse()
+		if err = call(mirrorClient); !_IsMirrorNodeFailure(err) {
+			client.mirrorNetwork._DecreaseBackoff(node)
+			return err
+		}
+
+		client.logger.Trace("retrying mirror node request on another node", "node", node._GetKey(), "error", err)
+		client.mirrorNetwork._IncreaseBackoff(node)
+	}
+
+	return err
+}
+
+// _IsMirrorNodeFailure reports whether err means the mirror node itself failed, rather than the request.
+func _IsMirrorNodeFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var mirrorErr *mirror.Error
+	if errors.As(err, &mirrorErr) {
+		return mirrorErr.StatusCode == http.StatusTooManyRequests || mirrorErr.StatusCode >= 500
+	}
+
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+// _MirrorRestClient returns a REST client for a node of the mirror network, configured with the HTTP settings of
+// the client.
+func (client *Client) _MirrorRestClient(node *_MirrorNode, localPort string) (*mirror.Client, error) {
+	mirrorUrl := node._GetKey()
+	index := strings.Index(mirrorUrl, ":")
+	if index == -1 {
+		return nil, errors.New("invalid mirrorUrl format")
+	}
+	mirrorUrl = mirrorUrl[:index]
+
+	baseURL := fmt.Sprintf("https://%s/api/v1", mirrorUrl)
+	if client.GetLedgerID().String() == "" {
+		baseURL = fmt.Sprintf("http://%s:%s/api/v1", mirrorUrl, localPort)
+	}
+
+	mirrorClient, err := mirror.NewClient(baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	mirrorClient.
+		SetHTTPClient(client.mirrorHTTPClient).
+		SetRequestTimeout(client.mirrorRequestTimeout).
+		SetMaxAttempts(client.mirrorMaxAttempts).
+		SetMinBackoff(client.minBackoff).
+		SetMaxBackoff(client.maxBackoff)
+	for key, values := range client.mirrorHeaders {
+		for _, value := range values {
+			mirrorClient.AddHeader(key, value)
+		}
+	}
+
+	return mirrorClient, nil
+}
+
+// SetMirrorHTTPClient sets the HTTP client used for requests to the REST API of the mirror network, eg. to route
+// them through a proxy. http.DefaultClient is used by default.
+func (client *Client) SetMirrorHTTPClient(httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	client.mirrorHTTPClient = httpClient
+
+	return client
+}
+
+// GetMirrorHTTPClient returns the HTTP client used for requests to the REST API of the mirror network.
+func (client *Client) GetMirrorHTTPClient() *http.Client {
+	return client.mirrorHTTPClient
+}
+
+// SetMirrorHeader sets a header sent with every request to the REST API of the mirror network, eg. the API key
+// of a commercial mirror node provider.
+func (client *Client) SetMirrorHeader(key string, value string) *Client {
+	client.mirrorHeaders.Set(key, value)
+
+	return client
+}
+
+// AddMirrorHeader adds a value to a header sent with every request to the REST API of the mirror network, keeping
+// the values it already has.
+func (client *Client) AddMirrorHeader(key string, value string) *Client {
+	client.mirrorHeaders.Add(key, value)
+
+	return client
+}
+
+// GetMirrorHeaders returns the headers sent with every request to the REST API of the mirror network.
+func (client *Client) GetMirrorHeaders() http.Header {
+	return client.mirrorHeaders.Clone()
+}
+
+// SetMirrorRequestTimeout sets the timeout of a single attempt of a request to the REST API of the mirror network.
+// Zero disables the timeout. The default is 30 seconds.
+func (client *Client) SetMirrorRequestTimeout(timeout time.Duration) *Client {
+	client.mirrorRequestTimeout = timeout
+
+	return client
+}
+
+// GetMirrorRequestTimeout returns the timeout of a single attempt of a request to the REST API of the mirror network.
+func (client *Client) GetMirrorRequestTimeout() time.Duration {
+	return client.mirrorRequestTimeout
+}
+
+// SetMirrorMaxAttempts sets the maximum number of attempts of a request to the REST API of the mirror network
+// answered with status 429 or 5xx. Retries wait for the Retry-After of the response, or else back off exponentially
+// between the client's min and max backoff. The default is 5.
+func (client *Client) SetMirrorMaxAttempts(maxAttempts int) *Client {
+	if maxAttempts < 1 {
+		panic("mirror max attempts must be at least 1")
+	}
+	client.mirrorMaxAttempts = maxAttempts
+
+	return client
+}
+
+// GetMirrorMaxAttempts returns the maximum number of attempts of a request to the REST API of the mirror network.
+func (client *Client) GetMirrorMaxAttempts() int {
+	return client.mirrorMaxAttempts
+}
+
 // SetTransportSecurity sets if transport security should be used to connect to consensus nodes.
 // If transport security is enabled all connections to consensus nodes will use TLS, and
 // the server's certificate hash will be compared to the hash stored in the NodeAddressBook
@@ -7,6 +7,7 @@ package hiero
 
 import (
 	"bytes"
+	"net/http"
 	"testing"
 	"time"
 
@@ -310,3 +311,59 @@ func TestUnitClientLogger(t *testing.T) {
 	hl := client.GetLogger()
 	assert.Equal(t, hl, hederaLoger)
 }
+
+func TestUnitClientGetMirrorRestClient(t *testing.T) {
+	t.Parallel()
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+
+	client.SetMirrorNetwork([]string{})
+	_, err = client.GetMirrorRestClient()
+	require.Error(t, err)
+
+	client.SetMirrorNetwork([]string{"testnet.mirrornode.hedera.com:443"})
+	client.SetLedgerID(*NewLedgerIDTestnet())
+	mirrorClient, err := client.GetMirrorRestClient()
+	require.NoError(t, err)
+	assert.Equal(t, "https://testnet.mirrornode.hedera.com/api/v1/", mirrorClient.GetBaseURL())
+
+	client.SetLedgerID(LedgerID{})
+	client.SetMirrorNetwork([]string{"127.0.0.1:5600"})
+	mirrorClient, err = client.GetMirrorRestClient()
+	require.NoError(t, err)
+	assert.Equal(t, "http://127.0.0.1:5551/api/v1/", mirrorClient.GetBaseURL())
+}
+
+func TestUnitClientMirrorHTTPSettings(t *testing.T) {
+	t.Parallel()
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+	client.SetMirrorNetwork([]string{"testnet.mirrornode.hedera.com:443"})
+	client.SetLedgerID(*NewLedgerIDTestnet())
+
+	httpClient := &http.Client{}
+	client.SetMirrorHTTPClient(httpClient).
+		SetMirrorHeader("X-Api-Key", "secret").
+		AddMirrorHeader("Accept-Encoding", "gzip").
+		AddMirrorHeader("Accept-Encoding", "br").
+		SetMirrorRequestTimeout(5 * time.Second).
+		SetMirrorMaxAttempts(2)
+
+	mirrorClient, err := client.GetMirrorRestClient()
+	require.NoError(t, err)
+	assert.Same(t, httpClient, mirrorClient.GetHTTPClient())
+	assert.Equal(t, "secret", mirrorClient.GetHeaders().Get("X-Api-Key"))
+	assert.Equal(t, []string{"gzip", "br"}, mirrorClient.GetHeaders().Values("Accept-Encoding"))
+	assert.Equal(t, 5*time.Second, mirrorClient.GetRequestTimeout())
+	assert.Equal(t, 2, mirrorClient.GetMaxAttempts())
+	assert.Equal(t, client.GetMinBackoff(), mirrorClient.GetMinBackoff())
+	assert.Equal(t, client.GetMaxBackoff(), mirrorClient.GetMaxBackoff())
+
+	// Headers of the client can't be changed through the returned copy
+	client.GetMirrorHeaders().Set("X-Api-Key", "other")
+	assert.Equal(t, "secret", client.GetMirrorHeaders().Get("X-Api-Key"))
+
+	assert.Panics(t, func() { client.SetMirrorMaxAttempts(0) })
+}
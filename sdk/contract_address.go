@@ -0,0 +1,68 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import "encoding/hex"
+
+// ContractIDFromCreate predicts the ID of the contract the deployer, a contract or an account with the given EVM
+// address, creates with CREATE when its nonce is nonce. The address is keccak256(rlp([deployer, nonce]))[12:].
+// Contracts start with a nonce of 1, as of EIP-161.
+//
+// The contract number of the returned ContractID is 0 until it is resolved with ContractID.PopulateContract once the
+// contract is deployed.
+func ContractIDFromCreate(shard uint64, realm uint64, deployer Address, nonce uint64) ContractID {
+	// The encoding of an address and an integer can't fail
+	encoded, _ := RLPEncode([]interface{}{deployer, nonce})
+	return _ContractIDFromAddressHash(shard, realm, Keccak256Hash(encoded))
+}
+
+// ContractIDFromCreate2 predicts the ID of the contract the deployer creates with CREATE2 from the salt and the
+// keccak-256 hash of the init code, the creation bytecode followed by the encoded constructor arguments. The address
+// is keccak256(0xff ++ deployer ++ salt ++ keccak256(initCode))[12:], as of EIP-1014.
+//
+// The contract number of the returned ContractID is 0 until it is resolved with ContractID.PopulateContract once the
+// contract is deployed.
+func ContractIDFromCreate2(shard uint64, realm uint64, deployer Address, salt [32]byte, initCodeHash Hash) ContractID {
+	data := make([]byte, 0, 85)
+	data = append(data, 0xff)
+	data = append(data, deployer[:]...)
+	data = append(data, salt[:]...)
+	data = append(data, initCodeHash[:]...)
+
+	return _ContractIDFromAddressHash(shard, realm, Keccak256Hash(data))
+}
+
+func _ContractIDFromAddressHash(shard uint64, realm uint64, hash Hash) ContractID {
+	evmAddress := make([]byte, 20)
+	copy(evmAddress, hash[12:])
+
+	return ContractID{
+		Shard:      shard,
+		Realm:      realm,
+		EvmAddress: evmAddress,
+	}
+}
+
+// _ContractEvmAddress returns the address a contract is known by in the EVM, which is its long zero address unless
+// the ID holds an EVM address
+func _ContractEvmAddress(id ContractID) Address {
+	if len(id.EvmAddress) == 20 {
+		return BytesToAddress(id.EvmAddress)
+	}
+
+	evmAddress, _ := hex.DecodeString(id.ToSolidityAddress())
+	return BytesToAddress(evmAddress)
+}
+
+// _PredictCreatedContractIDs predicts the IDs of the first count contracts a newly deployed contract creates with
+// CREATE, which use the nonces 1 to count
+func _PredictCreatedContractIDs(factory ContractID, count uint64) []ContractID {
+	deployer := _ContractEvmAddress(factory)
+
+	contractIDs := make([]ContractID, 0, count)
+	for nonce := uint64(1); nonce <= count; nonce++ {
+		contractIDs = append(contractIDs, ContractIDFromCreate(factory.Shard, factory.Realm, deployer, nonce))
+	}
+
+	return contractIDs
+}
@@ -0,0 +1,92 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _ContractAddressTestAddress(t *testing.T, address string) Address {
+	bytes, err := hex.DecodeString(address)
+	require.NoError(t, err)
+	return BytesToAddress(bytes)
+}
+
+func TestUnitContractIDFromCreate(t *testing.T) {
+	t.Parallel()
+
+	deployer := _ContractAddressTestAddress(t, "6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0")
+	expected := []string{
+		"cd234a471b72ba2f1ccf0a70fcaba648a5eecd8d",
+		"343c43a37d37dff08ae8c4a11544c718abb4fcf8",
+		"f778b86fa74e846c4f0a1fbd1335fe81c00a0c91",
+		"fffd933a0bc612844eaf0c6fe3e5b8e9b6c1d19c",
+	}
+
+	for nonce, address := range expected {
+		contractID := ContractIDFromCreate(0, 0, deployer, uint64(nonce))
+		assert.Equal(t, address, hex.EncodeToString(contractID.EvmAddress))
+		assert.Equal(t, uint64(0), contractID.Contract)
+	}
+
+	contractID := ContractIDFromCreate(1, 2, deployer, 0)
+	assert.Equal(t, "1.2.cd234a471b72ba2f1ccf0a70fcaba648a5eecd8d", contractID.String())
+}
+
+func TestUnitContractIDFromCreate2(t *testing.T) {
+	t.Parallel()
+
+	// The examples of EIP-1014
+	tests := []struct {
+		deployer string
+		salt     string
+		initCode string
+		expected string
+	}{
+		{"0000000000000000000000000000000000000000", "00", "00", "4d1a2e2bb4f88f0250f26ffff098b0b30b26bf38"},
+		{"deadbeef00000000000000000000000000000000", "00", "00", "b928f69bb1d91cd65274e3c79d8986362984fda3"},
+		{"00000000000000000000000000000000deadbeef", "cafebabe", "deadbeef", "60f3f640a8508fc6a86d45df051962668e1e8ac7"},
+		{"0000000000000000000000000000000000000000", "00", "", "e33c0c7f7df4809055c3eba6c09cfe4baf1bd9e0"},
+	}
+
+	for _, test := range tests {
+		salt, err := hex.DecodeString(test.salt)
+		require.NoError(t, err)
+		initCode, err := hex.DecodeString(test.initCode)
+		require.NoError(t, err)
+
+		var salt32 [32]byte
+		copy(salt32[32-len(salt):], salt)
+
+		contractID := ContractIDFromCreate2(0, 0, _ContractAddressTestAddress(t, test.deployer), salt32, Keccak256Hash(initCode))
+		assert.Equal(t, test.expected, hex.EncodeToString(contractID.EvmAddress))
+	}
+}
+
+func TestUnitContractCreateFlowPredictedContractIDs(t *testing.T) {
+	t.Parallel()
+
+	flow := NewContractCreateFlow().SetPredictedDeployments(2)
+	assert.Equal(t, uint64(2), flow.GetPredictedDeployments())
+	assert.Empty(t, flow.GetPredictedContractIDs())
+
+	factory := ContractID{Shard: 0, Realm: 0, Contract: 1234}
+	predicted := _PredictCreatedContractIDs(factory, 2)
+	require.Len(t, predicted, 2)
+
+	longZero := _ContractAddressTestAddress(t, factory.ToSolidityAddress())
+	assert.Equal(t, ContractIDFromCreate(0, 0, longZero, 1), predicted[0])
+	assert.Equal(t, ContractIDFromCreate(0, 0, longZero, 2), predicted[1])
+
+	// Contracts known by an EVM address deploy from it
+	evmFactory, err := ContractIDFromEvmAddress(0, 0, "6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0")
+	require.NoError(t, err)
+	assert.Equal(t, "0.0.343c43a37d37dff08ae8c4a11544c718abb4fcf8", _PredictCreatedContractIDs(evmFactory, 1)[0].String())
+}
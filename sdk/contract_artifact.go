@@ -0,0 +1,341 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// LinkReference is the position of the 20 bytes placeholder of a library address in a bytecode, in bytes
+type LinkReference struct {
+	Start  int `json:"start"`
+	Length int `json:"length"`
+}
+
+// LinkReferences are the positions of the library placeholders of a bytecode, by source file and library name
+type LinkReferences map[string]map[string][]LinkReference
+
+// ContractArtifact is a contract compiled by solc, Hardhat or Foundry. The bytecodes are hex encoded without a
+// 0x prefix, and contain placeholders for the addresses of the libraries the contract uses until it is linked.
+type ContractArtifact struct {
+	ContractName           string
+	SourceName             string
+	ABI                    *ABI
+	Bytecode               string
+	DeployedBytecode       string
+	LinkReferences         LinkReferences
+	DeployedLinkReferences LinkReferences
+}
+
+type _SolcBytecodeJSON struct {
+	Object         string         `json:"object"`
+	LinkReferences LinkReferences `json:"linkReferences"`
+}
+
+type _HardhatArtifactJSON struct {
+	ContractName           string          `json:"contractName"`
+	SourceName             string          `json:"sourceName"`
+	ABI                    json.RawMessage `json:"abi"`
+	Bytecode               string          `json:"bytecode"`
+	DeployedBytecode       string          `json:"deployedBytecode"`
+	LinkReferences         LinkReferences  `json:"linkReferences"`
+	DeployedLinkReferences LinkReferences  `json:"deployedLinkReferences"`
+}
+
+type _FoundryArtifactJSON struct {
+	ABI              json.RawMessage   `json:"abi"`
+	Bytecode         _SolcBytecodeJSON `json:"bytecode"`
+	DeployedBytecode _SolcBytecodeJSON `json:"deployedBytecode"`
+	Metadata         json.RawMessage   `json:"metadata"`
+}
+
+type _SolcOutputJSON struct {
+	Errors []struct {
+		Severity         string `json:"severity"`
+		FormattedMessage string `json:"formattedMessage"`
+	} `json:"errors"`
+	Contracts map[string]map[string]struct {
+		ABI json.RawMessage `json:"abi"`
+		EVM struct {
+			Bytecode         _SolcBytecodeJSON `json:"bytecode"`
+			DeployedBytecode _SolcBytecodeJSON `json:"deployedBytecode"`
+		} `json:"evm"`
+	} `json:"contracts"`
+}
+
+// ContractArtifactFromHardhat reads a Hardhat artifact, eg. artifacts/contracts/Token.sol/Token.json
+func ContractArtifactFromHardhat(data []byte) (*ContractArtifact, error) {
+	var artifact _HardhatArtifactJSON
+	if err := json.Unmarshal(data, &artifact); err != nil {
+		return nil, errors.Wrap(err, "failed to read the hardhat artifact")
+	}
+
+	abi, err := _ArtifactABI(artifact.ABI)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContractArtifact{
+		ContractName:           artifact.ContractName,
+		SourceName:             artifact.SourceName,
+		ABI:                    abi,
+		Bytecode:               strings.TrimPrefix(artifact.Bytecode, "0x"),
+		DeployedBytecode:       strings.TrimPrefix(artifact.DeployedBytecode, "0x"),
+		LinkReferences:         artifact.LinkReferences,
+		DeployedLinkReferences: artifact.DeployedLinkReferences,
+	}, nil
+}
+
+// ContractArtifactFromFoundry reads a Foundry artifact, eg. out/Token.sol/Token.json. The contract and source names
+// are read from the metadata, when Foundry includes it.
+func ContractArtifactFromFoundry(data []byte) (*ContractArtifact, error) {
+	var artifact _FoundryArtifactJSON
+	if err := json.Unmarshal(data, &artifact); err != nil {
+		return nil, errors.Wrap(err, "failed to read the foundry artifact")
+	}
+
+	abi, err := _ArtifactABI(artifact.ABI)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ContractArtifact{
+		ABI:                    abi,
+		Bytecode:               strings.TrimPrefix(artifact.Bytecode.Object, "0x"),
+		DeployedBytecode:       strings.TrimPrefix(artifact.DeployedBytecode.Object, "0x"),
+		LinkReferences:         artifact.Bytecode.LinkReferences,
+		DeployedLinkReferences: artifact.DeployedBytecode.LinkReferences,
+	}
+
+	var metadata struct {
+		Settings struct {
+			CompilationTarget map[string]string `json:"compilationTarget"`
+		} `json:"settings"`
+	}
+	if len(artifact.Metadata) > 0 && json.Unmarshal(artifact.Metadata, &metadata) == nil {
+		for source, name := range metadata.Settings.CompilationTarget {
+			result.SourceName, result.ContractName = source, name
+		}
+	}
+
+	return result, nil
+}
+
+// ContractArtifactsFromSolcOutput reads the contracts of the standard JSON output of solc, keyed by their fully
+// qualified name, eg. "contracts/Token.sol:Token". It fails when the compilation reported errors.
+func ContractArtifactsFromSolcOutput(data []byte) (map[string]*ContractArtifact, error) {
+	var output _SolcOutputJSON
+	if err := json.Unmarshal(data, &output); err != nil {
+		return nil, errors.Wrap(err, "failed to read the solc output")
+	}
+
+	for _, compilationError := range output.Errors {
+		if compilationError.Severity == "error" {
+			return nil, fmt.Errorf("solc compilation failed: %s", compilationError.FormattedMessage)
+		}
+	}
+
+	artifacts := map[string]*ContractArtifact{}
+	for source, contracts := range output.Contracts {
+		for name, contract := range contracts {
+			abi, err := _ArtifactABI(contract.ABI)
+			if err != nil {
+				return nil, errors.Wrapf(err, "contract %s:%s", source, name)
+			}
+
+			artifacts[source+":"+name] = &ContractArtifact{
+				ContractName:           name,
+				SourceName:             source,
+				ABI:                    abi,
+				Bytecode:               strings.TrimPrefix(contract.EVM.Bytecode.Object, "0x"),
+				DeployedBytecode:       strings.TrimPrefix(contract.EVM.DeployedBytecode.Object, "0x"),
+				LinkReferences:         contract.EVM.Bytecode.LinkReferences,
+				DeployedLinkReferences: contract.EVM.DeployedBytecode.LinkReferences,
+			}
+		}
+	}
+
+	return artifacts, nil
+}
+
+// ContractArtifactFromSolcOutput reads a contract of the standard JSON output of solc by its name, or by its fully
+// qualified name when several sources declare a contract with the same name
+func ContractArtifactFromSolcOutput(data []byte, contractName string) (*ContractArtifact, error) {
+	artifacts, err := ContractArtifactsFromSolcOutput(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if artifact, ok := artifacts[contractName]; ok {
+		return artifact, nil
+	}
+
+	var found *ContractArtifact
+	for _, artifact := range artifacts {
+		if artifact.ContractName != contractName {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("contract name %s is ambiguous, use its fully qualified name", contractName)
+		}
+		found = artifact
+	}
+	if found == nil {
+		return nil, fmt.Errorf("contract %s not found in the solc output", contractName)
+	}
+
+	return found, nil
+}
+
+// Link returns a copy of the artifact where the placeholders of the libraries are replaced with the EVM addresses of
+// the given contracts. Libraries are keyed by name, eg. "SafeMath", or by fully qualified name, eg.
+// "contracts/SafeMath.sol:SafeMath", which is required when several sources declare a library with the same name.
+// Libraries which aren't given are left unlinked, so that linking can be done in several steps.
+func (a *ContractArtifact) Link(libraries map[string]ContractID) (*ContractArtifact, error) {
+	linked := *a
+
+	var err error
+	if linked.Bytecode, linked.LinkReferences, err = _LinkBytecode(a.Bytecode, a.LinkReferences, libraries); err != nil {
+		return nil, err
+	}
+	if linked.DeployedBytecode, linked.DeployedLinkReferences, err =
+		_LinkBytecode(a.DeployedBytecode, a.DeployedLinkReferences, libraries); err != nil {
+		return nil, err
+	}
+
+	return &linked, nil
+}
+
+// UnlinkedLibraries returns the fully qualified names of the libraries which still have to be linked
+func (a *ContractArtifact) UnlinkedLibraries() []string {
+	names := map[string]bool{}
+	for _, references := range []LinkReferences{a.LinkReferences, a.DeployedLinkReferences} {
+		for source, libraries := range references {
+			for name := range libraries {
+				names[source+":"+name] = true
+			}
+		}
+	}
+
+	result := make([]string, 0, len(names))
+	for name := range names {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+
+	return result
+}
+
+// BytecodeBytes returns the creation bytecode, which fails when libraries are left unlinked
+func (a *ContractArtifact) BytecodeBytes() ([]byte, error) {
+	return a._DecodeBytecode(a.Bytecode)
+}
+
+// DeployedBytecodeBytes returns the runtime bytecode, which fails when libraries are left unlinked
+func (a *ContractArtifact) DeployedBytecodeBytes() ([]byte, error) {
+	return a._DecodeBytecode(a.DeployedBytecode)
+}
+
+// EncodeConstructor encodes the arguments of the constructor of the ABI of the artifact
+func (a *ContractArtifact) EncodeConstructor(args ...interface{}) ([]byte, error) {
+	if a.ABI == nil || a.ABI.Constructor == nil {
+		if len(args) > 0 {
+			return nil, fmt.Errorf("contract %s has no constructor but got %d arguments", a.ContractName, len(args))
+		}
+		return nil, nil
+	}
+
+	inputs := a.ABI.Constructor.Inputs
+	if len(args) != len(inputs.TupleElems()) {
+		return nil, fmt.Errorf("constructor of %s expects %d arguments but got %d", a.ContractName, len(inputs.TupleElems()), len(args))
+	}
+
+	return Encode(args, inputs)
+}
+
+func (a *ContractArtifact) _DecodeBytecode(bytecode string) ([]byte, error) {
+	if unlinked := a.UnlinkedLibraries(); len(unlinked) > 0 {
+		return nil, fmt.Errorf("bytecode must be linked with the libraries %s", strings.Join(unlinked, ", "))
+	}
+
+	decoded, err := hex.DecodeString(bytecode)
+	if err != nil {
+		if strings.Contains(bytecode, "__") {
+			return nil, errors.New("bytecode contains unlinked library placeholders")
+		}
+		return nil, err
+	}
+
+	return decoded, nil
+}
+
+func _ArtifactABI(raw json.RawMessage) (*ABI, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+
+	return NewABIFromReader(bytes.NewReader(raw))
+}
+
+// _LinkBytecode replaces the placeholders of the given libraries, at the positions of the link references, and
+// returns the bytecode with the references which are left
+func _LinkBytecode(bytecode string, references LinkReferences, libraries map[string]ContractID) (string, LinkReferences, error) {
+	// A library given by name only must not be ambiguous between several sources
+	sources := map[string]int{}
+	for _, sourceLibraries := range references {
+		for name := range sourceLibraries {
+			sources[name]++
+		}
+	}
+
+	code := []byte(bytecode)
+	remaining := LinkReferences{}
+	for source, sourceLibraries := range references {
+		for name, offsets := range sourceLibraries {
+			library, ok := libraries[source+":"+name]
+			if !ok {
+				if library, ok = libraries[name]; ok && sources[name] > 1 {
+					return "", nil, fmt.Errorf("library name %s is ambiguous, use its fully qualified name", name)
+				}
+			}
+			if !ok {
+				if remaining[source] == nil {
+					remaining[source] = map[string][]LinkReference{}
+				}
+				remaining[source][name] = offsets
+				continue
+			}
+
+			address := hex.EncodeToString(_ContractEvmAddress(library).Bytes())
+			for _, offset := range offsets {
+				start, end := offset.Start*2, (offset.Start+offset.Length)*2
+				if offset.Length != 20 || start < 0 || end > len(code) {
+					return "", nil, fmt.Errorf("invalid link reference of %s:%s at %d", source, name, offset.Start)
+				}
+				copy(code[start:end], address)
+			}
+		}
+	}
+
+	// Placeholders are derived from the fully qualified names, which links bytecodes without link references
+	for name, library := range libraries {
+		if strings.Contains(name, ":") {
+			placeholder := "__$" + hex.EncodeToString(Keccak256Hash([]byte(name)).Bytes())[:34] + "$__"
+			address := hex.EncodeToString(_ContractEvmAddress(library).Bytes())
+			code = bytes.ReplaceAll(code, []byte(placeholder), []byte(address))
+		}
+	}
+
+	if len(remaining) == 0 {
+		remaining = nil
+	}
+
+	return string(code), remaining, nil
+}
@@ -0,0 +1,190 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testArtifactABI = `[{"type":"constructor","inputs":[{"name":"owner","type":"address"},{"name":"supply","type":"uint256"}]},` +
+	`{"type":"function","name":"supply","inputs":[],"outputs":[{"name":"","type":"uint256"}],"stateMutability":"view"}]`
+
+func testArtifactPlaceholder() string {
+	return "__$" + hex.EncodeToString(Keccak256Hash([]byte("contracts/Lib.sol:Lib")).Bytes())[:34] + "$__"
+}
+
+// The placeholder is the operand of a PUSH20, which starts at byte 4
+func testArtifactBytecode() string {
+	return "608060" + "73" + testArtifactPlaceholder() + "00"
+}
+
+const testArtifactLinkReferences = `{"contracts/Lib.sol":{"Lib":[{"start":4,"length":20}]}}`
+
+func testArtifactReferences(t *testing.T) LinkReferences {
+	var references LinkReferences
+	require.NoError(t, json.Unmarshal([]byte(testArtifactLinkReferences), &references))
+	return references
+}
+
+func TestUnitContractArtifactFromSolcOutput(t *testing.T) {
+	t.Parallel()
+
+	output := `{"contracts":{` +
+		`"contracts/Token.sol":{"Token":{"abi":` + testArtifactABI + `,"evm":{` +
+		`"bytecode":{"object":"` + testArtifactBytecode() + `","linkReferences":` + testArtifactLinkReferences + `},` +
+		`"deployedBytecode":{"object":"6000","linkReferences":{}}}}},` +
+		`"contracts/Lib.sol":{"Lib":{"abi":[],"evm":{"bytecode":{"object":"6001"}}}},` +
+		`"contracts/Other.sol":{"Lib":{"abi":[],"evm":{"bytecode":{"object":"6002"}}}}}}`
+
+	artifact, err := ContractArtifactFromSolcOutput([]byte(output), "Token")
+	require.NoError(t, err)
+	assert.Equal(t, "Token", artifact.ContractName)
+	assert.Equal(t, "contracts/Token.sol", artifact.SourceName)
+	assert.NotNil(t, artifact.ABI.Constructor)
+	assert.Equal(t, "6000", artifact.DeployedBytecode)
+	assert.Equal(t, []string{"contracts/Lib.sol:Lib"}, artifact.UnlinkedLibraries())
+
+	_, err = ContractArtifactFromSolcOutput([]byte(output), "Lib")
+	require.ErrorContains(t, err, "ambiguous")
+
+	lib, err := ContractArtifactFromSolcOutput([]byte(output), "contracts/Other.sol:Lib")
+	require.NoError(t, err)
+	assert.Equal(t, "6002", lib.Bytecode)
+
+	_, err = ContractArtifactFromSolcOutput([]byte(output), "Missing")
+	require.Error(t, err)
+
+	_, err = ContractArtifactsFromSolcOutput([]byte(`{"errors":[{"severity":"error","formattedMessage":"ParserError"}]}`))
+	require.ErrorContains(t, err, "ParserError")
+}
+
+func TestUnitContractArtifactFromHardhat(t *testing.T) {
+	t.Parallel()
+
+	data := `{"_format":"hh-sol-artifact-1","contractName":"Token","sourceName":"contracts/Token.sol","abi":` + testArtifactABI +
+		`,"bytecode":"0x` + testArtifactBytecode() + `","deployedBytecode":"0x6000",` +
+		`"linkReferences":` + testArtifactLinkReferences + `,"deployedLinkReferences":{}}`
+
+	artifact, err := ContractArtifactFromHardhat([]byte(data))
+	require.NoError(t, err)
+	assert.Equal(t, "Token", artifact.ContractName)
+	assert.Equal(t, "contracts/Token.sol", artifact.SourceName)
+	assert.Equal(t, testArtifactBytecode(), artifact.Bytecode)
+	assert.Equal(t, "6000", artifact.DeployedBytecode)
+	assert.Equal(t, []string{"contracts/Lib.sol:Lib"}, artifact.UnlinkedLibraries())
+
+	_, err = ContractArtifactFromHardhat([]byte("not json"))
+	require.Error(t, err)
+}
+
+func TestUnitContractArtifactFromFoundry(t *testing.T) {
+	t.Parallel()
+
+	data := `{"abi":` + testArtifactABI + `,` +
+		`"bytecode":{"object":"0x` + testArtifactBytecode() + `","linkReferences":` + testArtifactLinkReferences + `},` +
+		`"deployedBytecode":{"object":"0x6000","linkReferences":{}},` +
+		`"metadata":{"settings":{"compilationTarget":{"src/Token.sol":"Token"}}}}`
+
+	artifact, err := ContractArtifactFromFoundry([]byte(data))
+	require.NoError(t, err)
+	assert.Equal(t, "Token", artifact.ContractName)
+	assert.Equal(t, "src/Token.sol", artifact.SourceName)
+	assert.Equal(t, testArtifactBytecode(), artifact.Bytecode)
+	assert.Equal(t, []string{"contracts/Lib.sol:Lib"}, artifact.UnlinkedLibraries())
+}
+
+func TestUnitContractArtifactLink(t *testing.T) {
+	t.Parallel()
+
+	artifact := &ContractArtifact{Bytecode: testArtifactBytecode(), LinkReferences: testArtifactReferences(t)}
+
+	_, err := artifact.BytecodeBytes()
+	require.ErrorContains(t, err, "contracts/Lib.sol:Lib")
+
+	// Libraries are linked with their long zero address by name
+	linked, err := artifact.Link(map[string]ContractID{"Lib": {Shard: 0, Realm: 0, Contract: 1234}})
+	require.NoError(t, err)
+	assert.Empty(t, linked.UnlinkedLibraries())
+	assert.Equal(t, "608060"+"73"+"00000000000000000000000000000000000004d2"+"00", linked.Bytecode)
+	assert.Equal(t, testArtifactBytecode(), artifact.Bytecode)
+
+	bytecode, err := linked.BytecodeBytes()
+	require.NoError(t, err)
+	assert.Len(t, bytecode, 25)
+
+	// Or with their EVM address by fully qualified name
+	evmAddress, err := hex.DecodeString("5b38da6a701c568545dcfcb03fcb875f56beddc4")
+	require.NoError(t, err)
+	linked, err = artifact.Link(map[string]ContractID{"contracts/Lib.sol:Lib": {EvmAddress: evmAddress}})
+	require.NoError(t, err)
+	assert.Equal(t, "608060"+"73"+"5b38da6a701c568545dcfcb03fcb875f56beddc4"+"00", linked.Bytecode)
+
+	// Placeholders are replaced without link references too
+	linked, err = (&ContractArtifact{Bytecode: testArtifactBytecode()}).Link(map[string]ContractID{"contracts/Lib.sol:Lib": {Contract: 1234}})
+	require.NoError(t, err)
+	assert.False(t, strings.Contains(linked.Bytecode, "__"))
+
+	_, err = (&ContractArtifact{Bytecode: testArtifactBytecode()}).BytecodeBytes()
+	require.ErrorContains(t, err, "placeholders")
+
+	// Other libraries are left unlinked
+	linked, err = artifact.Link(map[string]ContractID{"Other": {Contract: 1}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"contracts/Lib.sol:Lib"}, linked.UnlinkedLibraries())
+
+	// A name shared by libraries of several sources must be fully qualified
+	ambiguous := &ContractArtifact{Bytecode: testArtifactBytecode() + testArtifactBytecode(), LinkReferences: LinkReferences{
+		"contracts/Lib.sol":   {"Lib": {{Start: 4, Length: 20}}},
+		"contracts/Other.sol": {"Lib": {{Start: 29, Length: 20}}},
+	}}
+	_, err = ambiguous.Link(map[string]ContractID{"Lib": {Contract: 1}})
+	require.ErrorContains(t, err, "ambiguous")
+
+	linked, err = ambiguous.Link(map[string]ContractID{"contracts/Lib.sol:Lib": {Contract: 1}, "contracts/Other.sol:Lib": {Contract: 2}})
+	require.NoError(t, err)
+	assert.Empty(t, linked.UnlinkedLibraries())
+	assert.Equal(t, "608060"+"73"+"0000000000000000000000000000000000000001"+"00"+
+		"608060"+"73"+"0000000000000000000000000000000000000002"+"00", linked.Bytecode)
+
+	invalid := &ContractArtifact{Bytecode: "6000", LinkReferences: testArtifactReferences(t)}
+	_, err = invalid.Link(map[string]ContractID{"Lib": {Contract: 1}})
+	require.Error(t, err)
+}
+
+func TestUnitContractCreateFlowSetArtifact(t *testing.T) {
+	t.Parallel()
+
+	abi, err := NewABI(testArtifactABI)
+	require.NoError(t, err)
+	artifact := &ContractArtifact{ContractName: "Token", ABI: abi, Bytecode: "6080"}
+
+	owner := BytesToAddress([]byte{1})
+	flow, err := NewContractCreateFlow().SetArtifact(artifact, owner, big.NewInt(1000))
+	require.NoError(t, err)
+	assert.Equal(t, hex.EncodeToString([]byte("6080")), flow.GetBytecode())
+
+	expected, err := Encode([]interface{}{owner, big.NewInt(1000)}, abi.Constructor.Inputs)
+	require.NoError(t, err)
+	assert.Equal(t, expected, flow.GetConstructorParameters())
+	assert.Len(t, expected, 64)
+
+	_, err = NewContractCreateFlow().SetArtifact(artifact, owner)
+	require.ErrorContains(t, err, "expects 2 arguments")
+
+	_, err = NewContractCreateFlow().SetArtifact(&ContractArtifact{Bytecode: "6080"}, owner)
+	require.ErrorContains(t, err, "no constructor")
+
+	unlinked := &ContractArtifact{ABI: abi, Bytecode: testArtifactBytecode(), LinkReferences: testArtifactReferences(t)}
+	_, err = NewContractCreateFlow().SetArtifact(unlinked, owner, big.NewInt(1000))
+	require.Error(t, err)
+}
@@ -102,6 +102,18 @@ func (q *ContractCallQuery) SetFunction(name string, params *ContractFunctionPar
 	return q
 }
 
+// SetFunctionFromABI sets the function to call and its arguments, encoded with the method like
+// ContractExecuteTransaction.SetFunctionFromABI
+func (q *ContractCallQuery) SetFunctionFromABI(method *Method, args ...interface{}) (*ContractCallQuery, error) {
+	data, err := _EncodeCall(method, args)
+	if err != nil {
+		return q, err
+	}
+
+	q.functionParameters = data
+	return q, nil
+}
+
 // SetFunctionParameters sets the function parameters as their raw bytes.
 func (q *ContractCallQuery) SetFunctionParameters(byteArray []byte) *ContractCallQuery {
 	q.functionParameters = byteArray
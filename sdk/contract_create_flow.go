@@ -24,6 +24,8 @@ type ContractCreateFlow struct {
 	maxAutomaticTokenAssociations int32
 	maxChunks                     *uint64
 	memo                          string
+	predictedDeployments          uint64
+	predictedContractIDs          []ContractID
 }
 
 // NewContractCreateFlow creates a new ContractCreateFlow transaction builder object.
@@ -47,6 +49,25 @@ func (tx *ContractCreateFlow) SetBytecode(bytecode []byte) *ContractCreateFlow {
 	return tx
 }
 
+// SetArtifact sets the bytecode of the contract from a compiler artifact, which must have been linked with its
+// libraries, and the constructor parameters from the arguments encoded with the ABI of the artifact.
+func (tx *ContractCreateFlow) SetArtifact(artifact *ContractArtifact, constructorArgs ...interface{}) (*ContractCreateFlow, error) {
+	bytecode, err := artifact.BytecodeBytes()
+	if err != nil {
+		return tx, err
+	}
+
+	parameters, err := artifact.EncodeConstructor(constructorArgs...)
+	if err != nil {
+		return tx, err
+	}
+
+	// The bytecode is uploaded to a file, which the network expects to hold the bytecode as hex text
+	tx.bytecode = []byte(hex.EncodeToString(bytecode))
+	tx.parameters = parameters
+	return tx, nil
+}
+
 // GetBytecode returns the hex-encoded bytecode of the contract.
 func (tx *ContractCreateFlow) GetBytecode() string {
 	return hex.EncodeToString(tx.bytecode)
@@ -199,6 +220,26 @@ func (tx *ContractCreateFlow) GetMaxAutomaticTokenAssociations() int32 {
 	return tx.maxAutomaticTokenAssociations
 }
 
+// SetPredictedDeployments sets the number of contracts the new contract is expected to deploy with CREATE, from its
+// constructor or from later factory calls. Execute then predicts their IDs, which are returned by
+// GetPredictedContractIDs, so that they can be funded or associated with tokens before they exist.
+func (tx *ContractCreateFlow) SetPredictedDeployments(count uint64) *ContractCreateFlow {
+	tx.predictedDeployments = count
+	return tx
+}
+
+// GetPredictedDeployments returns the number of contracts the new contract is expected to deploy
+func (tx *ContractCreateFlow) GetPredictedDeployments() uint64 {
+	return tx.predictedDeployments
+}
+
+// GetPredictedContractIDs returns the IDs of the contracts the contract created by the last Execute deploys with
+// CREATE, in the order they are deployed. Their contract numbers are 0 until they are resolved with
+// ContractID.PopulateContract.
+func (tx *ContractCreateFlow) GetPredictedContractIDs() []ContractID {
+	return tx.predictedContractIDs
+}
+
 func (tx *ContractCreateFlow) splitBytecode() *ContractCreateFlow {
 	if len(tx.bytecode) > 2048 {
 		tx.createBytecode = tx.bytecode[0:2048]
@@ -306,11 +347,16 @@ func (tx *ContractCreateFlow) Execute(client *Client) (TransactionResponse, erro
 	if err != nil {
 		return TransactionResponse{}, err
 	}
-	_, err = contractCreateResponse.SetValidateStatus(true).GetReceipt(client)
+	contractCreateReceipt, err := contractCreateResponse.SetValidateStatus(true).GetReceipt(client)
 	if err != nil {
 		return TransactionResponse{}, err
 	}
 
+	tx.predictedContractIDs = nil
+	if tx.predictedDeployments > 0 && contractCreateReceipt.ContractID != nil {
+		tx.predictedContractIDs = _PredictCreatedContractIDs(*contractCreateReceipt.ContractID, tx.predictedDeployments)
+	}
+
 	return contractCreateResponse, nil
 }
 
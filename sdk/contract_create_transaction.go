@@ -320,6 +320,10 @@ func (tx ContractCreateTransaction) getName() string {
 	return "ContractCreateTransaction"
 }
 
+func (tx ContractCreateTransaction) validateBody() []ErrTransactionValidation {
+	return _ValidateMemo("contract memo", tx.memo)
+}
+
 func (tx ContractCreateTransaction) validateNetworkOnIDs(client *Client) error {
 	if client == nil || !client.autoValidateChecksums {
 		return nil
@@ -0,0 +1,402 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/sdk/mirror"
+	"github.com/pkg/errors"
+)
+
+// The mirror node only accepts topic filters together with a timestamp range of at most 7 days
+const contractEventQueryMaxRange = 7 * 24 * time.Hour
+
+// Windows which ended longer ago than this are assumed to have been fully imported by the mirror node
+const contractEventQueryImportDelay = time.Minute
+
+// ContractEventCheckpoint is the position of the last log delivered by a ContractEventQuery. Persist it from the
+// checkpoint handler and pass it to SetCheckpoint to resume a subscription after a restart.
+type ContractEventCheckpoint struct {
+	Timestamp time.Time
+	Index     int64
+}
+
+// ContractEvent is a log emitted by a contract, as delivered by ContractEventQuery. Event and Values are set when
+// the query has an ABI with an event matching the log.
+type ContractEvent struct {
+	DecodedLog
+	ConsensusTimestamp time.Time
+	Index              int64
+	BlockNumber        int64
+	TransactionHash    []byte
+}
+
+// ContractEventQuery
+// Query that polls the mirror node for the logs emitted by a contract, or by all contracts, and delivers each of
+// them exactly once.
+type ContractEventQuery struct {
+	contractID        *ContractID
+	topics            [4][][]byte
+	abi               *ABI
+	startTime         *time.Time
+	checkpoint        *ContractEventCheckpoint
+	checkpointHandler func(ContractEventCheckpoint)
+	errorHandler      func(err error)
+	pollInterval      time.Duration
+	pageSize          int
+}
+
+// NewContractEventQuery creates ContractEventQuery which
+// polls the mirror node for the logs emitted by contracts
+func NewContractEventQuery() *ContractEventQuery {
+	return &ContractEventQuery{
+		pollInterval: 2 * time.Second,
+		pageSize:     100,
+	}
+}
+
+// SetContractID sets the contract whose logs are delivered. The logs of all contracts are delivered when it isn't set.
+func (query *ContractEventQuery) SetContractID(contractID ContractID) *ContractEventQuery {
+	query.contractID = &contractID
+	return query
+}
+
+// GetContractID returns the contract whose logs are delivered
+func (query *ContractEventQuery) GetContractID() ContractID {
+	if query.contractID == nil {
+		return ContractID{}
+	}
+
+	return *query.contractID
+}
+
+// SetTopic only delivers the logs whose topic at index (0 to 3) is one of the given values. Topic 0 is the id of
+// the event for non-anonymous events.
+func (query *ContractEventQuery) SetTopic(index int, values ...[]byte) *ContractEventQuery {
+	if index < 0 || index >= len(query.topics) {
+		panic(fmt.Sprintf("topic index %d is out of range", index))
+	}
+	query.topics[index] = values
+	return query
+}
+
+// GetTopic returns the values accepted for the topic at index
+func (query *ContractEventQuery) GetTopic(index int) [][]byte {
+	if index < 0 || index >= len(query.topics) {
+		return nil
+	}
+
+	return query.topics[index]
+}
+
+// SetEvents only delivers the logs emitted by the given events, by filtering on topic 0
+func (query *ContractEventQuery) SetEvents(events ...*Event) *ContractEventQuery {
+	ids := make([][]byte, 0, len(events))
+	for _, event := range events {
+		id := event.ID()
+		ids = append(ids, id[:])
+	}
+	return query.SetTopic(0, ids...)
+}
+
+// SetABI decodes the delivered logs with the events of the ABI
+func (query *ContractEventQuery) SetABI(abi *ABI) *ContractEventQuery {
+	query.abi = abi
+	return query
+}
+
+// GetABI returns the ABI the delivered logs are decoded with
+func (query *ContractEventQuery) GetABI() *ABI {
+	return query.abi
+}
+
+// SetStartTime sets the consensus time of the first delivered log. Without a start time or a checkpoint only the
+// logs emitted after subscribing are delivered.
+func (query *ContractEventQuery) SetStartTime(startTime time.Time) *ContractEventQuery {
+	query.startTime = &startTime
+	return query
+}
+
+// GetStartTime returns the consensus time of the first delivered log
+func (query *ContractEventQuery) GetStartTime() time.Time {
+	if query.startTime == nil {
+		return time.Time{}
+	}
+
+	return *query.startTime
+}
+
+// SetCheckpoint resumes the delivery after the log at the checkpoint, which takes precedence over the start time
+func (query *ContractEventQuery) SetCheckpoint(checkpoint ContractEventCheckpoint) *ContractEventQuery {
+	query.checkpoint = &checkpoint
+	return query
+}
+
+// GetCheckpoint returns the checkpoint the delivery resumes from
+func (query *ContractEventQuery) GetCheckpoint() ContractEventCheckpoint {
+	if query.checkpoint == nil {
+		return ContractEventCheckpoint{}
+	}
+
+	return *query.checkpoint
+}
+
+// SetCheckpointHandler sets the handler called with the checkpoint of each log once it has been delivered
+func (query *ContractEventQuery) SetCheckpointHandler(checkpointHandler func(ContractEventCheckpoint)) *ContractEventQuery {
+	query.checkpointHandler = checkpointHandler
+	return query
+}
+
+// SetErrorHandler sets the handler called when polling the mirror node fails. Polling is retried at the next
+// interval. Without a handler the error is logged as a warning through the logger of the client.
+func (query *ContractEventQuery) SetErrorHandler(errorHandler func(err error)) *ContractEventQuery {
+	query.errorHandler = errorHandler
+	return query
+}
+
+// SetPollInterval sets how long to wait between polls once all the logs have been delivered
+func (query *ContractEventQuery) SetPollInterval(pollInterval time.Duration) *ContractEventQuery {
+	query.pollInterval = pollInterval
+	return query
+}
+
+// GetPollInterval returns how long to wait between polls
+func (query *ContractEventQuery) GetPollInterval() time.Duration {
+	return query.pollInterval
+}
+
+// SetPageSize sets the number of logs fetched per request
+func (query *ContractEventQuery) SetPageSize(pageSize int) *ContractEventQuery {
+	query.pageSize = pageSize
+	return query
+}
+
+// GetPageSize returns the number of logs fetched per request
+func (query *ContractEventQuery) GetPageSize() int {
+	return query.pageSize
+}
+
+func (query *ContractEventQuery) _Validate(client *Client) error {
+	if client == nil {
+		return errNoClientProvided
+	}
+
+	if query.pollInterval <= 0 {
+		return errors.New("poll interval must be positive")
+	}
+
+	if query.contractID != nil && client.GetAutoValidateChecksums() {
+		if err := query.contractID.ValidateChecksum(client); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Subscribe polls the mirror node and calls onLog with each log, in consensus order, until the handle is
+// unsubscribed
+func (query *ContractEventQuery) Subscribe(client *Client, onLog func(ContractEvent)) (SubscriptionHandle, error) {
+	if err := query._Validate(client); err != nil {
+		return SubscriptionHandle{}, err
+	}
+
+	var cursor time.Time
+	var checkpoint *ContractEventCheckpoint
+	switch {
+	case query.checkpoint != nil:
+		cp := *query.checkpoint
+		checkpoint = &cp
+		cursor = cp.Timestamp
+	case query.startTime != nil:
+		cursor = *query.startTime
+	default:
+		cursor = time.Now()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	handle := SubscriptionHandle{onUnsubscribe: cancel}
+
+	go func() {
+		for {
+			caughtUp, err := query._Poll(ctx, client, &cursor, &checkpoint, onLog)
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				if query.errorHandler != nil {
+					query.errorHandler(err)
+				} else {
+					client.logger.Warn("failed to poll contract logs", "error", err)
+				}
+			}
+
+			if caughtUp || err != nil {
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(query.pollInterval):
+				}
+			}
+		}
+	}()
+
+	return handle, nil
+}
+
+// _Poll delivers the logs of the window starting at cursor and reports whether it reached the present
+func (query *ContractEventQuery) _Poll(
+	ctx context.Context,
+	client *Client,
+	cursor *time.Time,
+	checkpoint **ContractEventCheckpoint,
+	onLog func(ContractEvent),
+) (bool, error) {
+	now := time.Now()
+	end := cursor.Add(contractEventQueryMaxRange)
+	caughtUp := !end.Before(now)
+	if caughtUp {
+		end = now
+	}
+
+	err := client._CallMirrorRest("5551", func(mirrorClient *mirror.Client) error {
+		var request *mirror.ListRequest[mirror.ContractLog]
+		if query.contractID != nil {
+			request = mirrorClient.ListContractLogs(query.contractID.String())
+		} else {
+			request = mirrorClient.ListAllContractLogs()
+		}
+
+		request.Order(mirror.OrderAsc).
+			Limit(query.pageSize).
+			Timestamp(mirror.OperatorGte, mirror.TimestampFromTime(*cursor)).
+			Timestamp(mirror.OperatorLte, mirror.TimestampFromTime(end))
+		for index, values := range query.topics {
+			for _, value := range values {
+				request.AddParam(fmt.Sprintf("topic%d", index), "0x"+hex.EncodeToString(value))
+			}
+		}
+
+		var err error
+		request.Iterate(ctx)(func(log mirror.ContractLog, iterErr error) bool {
+			if iterErr != nil {
+				err = iterErr
+				return false
+			}
+
+			var event ContractEvent
+			if event, err = query._EventFromMirror(log); err != nil {
+				return false
+			}
+
+			if cp := *checkpoint; cp != nil && !_IsAfterCheckpoint(event, *cp) {
+				return true
+			}
+
+			onLog(event)
+
+			*checkpoint = &ContractEventCheckpoint{Timestamp: event.ConsensusTimestamp, Index: event.Index}
+			*cursor = event.ConsensusTimestamp
+			if query.checkpointHandler != nil {
+				query.checkpointHandler(**checkpoint)
+			}
+
+			return ctx.Err() == nil
+		})
+
+		return err
+	})
+	if err != nil {
+		return caughtUp, err
+	}
+
+	// The window can be skipped once the mirror node has imported all of it. The logs at the end of a window are
+	// delivered again by the next one, which is why the cursor doesn't move past them.
+	if !caughtUp && end.Before(now.Add(-contractEventQueryImportDelay)) && cursor.Before(end) {
+		*cursor = end
+	}
+
+	return caughtUp, nil
+}
+
+func (query *ContractEventQuery) _EventFromMirror(log mirror.ContractLog) (ContractEvent, error) {
+	contractID, err := ContractIDFromString(log.ContractID)
+	if err != nil {
+		return ContractEvent{}, err
+	}
+
+	timestamp, err := log.Timestamp.Time()
+	if err != nil {
+		return ContractEvent{}, err
+	}
+
+	bloom, err := _DecodeMirrorHex(log.Bloom)
+	if err != nil {
+		return ContractEvent{}, err
+	}
+
+	data, err := _DecodeMirrorHex(log.Data)
+	if err != nil {
+		return ContractEvent{}, err
+	}
+
+	topics := make([][]byte, 0, len(log.Topics))
+	for _, topic := range log.Topics {
+		decoded, err := _DecodeMirrorHex(topic)
+		if err != nil {
+			return ContractEvent{}, err
+		}
+		topics = append(topics, decoded)
+	}
+
+	transactionHash, err := _DecodeMirrorHex(log.TransactionHash)
+	if err != nil {
+		return ContractEvent{}, err
+	}
+
+	event := ContractEvent{
+		DecodedLog: DecodedLog{
+			Log: ContractLogInfo{
+				ContractID: contractID,
+				Bloom:      bloom,
+				Topics:     topics,
+				Data:       data,
+			},
+		},
+		ConsensusTimestamp: timestamp,
+		Index:              log.Index,
+		BlockNumber:        log.BlockNumber,
+		TransactionHash:    transactionHash,
+	}
+
+	// Logs of events missing from the ABI are delivered without being decoded
+	if query.abi != nil && len(topics) > 0 {
+		if abiEvent := query.abi.GetEventByID(topics[0]); abiEvent != nil {
+			values, err := abiEvent.ParseLog(event.Log)
+			if err != nil {
+				return ContractEvent{}, errors.Wrapf(err, "decoding log %d at %s", log.Index, log.Timestamp)
+			}
+			event.Event = abiEvent
+			event.Values = values
+		}
+	}
+
+	return event, nil
+}
+
+func _IsAfterCheckpoint(event ContractEvent, checkpoint ContractEventCheckpoint) bool {
+	if event.ConsensusTimestamp.Equal(checkpoint.Timestamp) {
+		return event.Index > checkpoint.Index
+	}
+
+	return event.ConsensusTimestamp.After(checkpoint.Timestamp)
+}
+
+func _DecodeMirrorHex(value string) ([]byte, error) {
+	return hex.DecodeString(strings.TrimPrefix(value, "0x"))
+}
@@ -0,0 +1,229 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/sdk/mirror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitContractEventQuerySubscribe(t *testing.T) {
+	t.Parallel()
+
+	abi, err := NewABI(logTestABI)
+	require.NoError(t, err)
+	transferID := abi.Events["Transfer"].ID()
+	otherTopic := _LogTestWord("ff")
+
+	base := time.Now().Add(-time.Hour)
+	newLog := func(offset time.Duration, index int64, topics ...[]byte) mirror.ContractLog {
+		hexTopics := make([]string, 0, len(topics))
+		for _, topic := range topics {
+			hexTopics = append(hexTopics, "0x"+hex.EncodeToString(topic))
+		}
+		return mirror.ContractLog{
+			ContractID:      "0.0.1001",
+			Data:            "0x" + hex.EncodeToString(_LogTestWord("64")),
+			Index:           index,
+			Topics:          hexTopics,
+			BlockNumber:     7,
+			Timestamp:       mirror.TimestampFromTime(base.Add(offset)),
+			TransactionHash: "0x0102",
+		}
+	}
+
+	var mutex sync.Mutex
+	logs := []mirror.ContractLog{
+		newLog(0, 0, transferID[:], _LogTestWord("0405"), _LogTestWord("0406")),
+		newLog(0, 1, transferID[:], _LogTestWord("0405"), _LogTestWord("0407")),
+		newLog(time.Second, 0, otherTopic),
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/contracts/0.0.1001/results/logs", r.URL.Path)
+		assert.Equal(t, "asc", r.URL.Query().Get("order"))
+		assert.ElementsMatch(t, []string{"0x" + hex.EncodeToString(transferID[:]), "0x" + hex.EncodeToString(otherTopic)},
+			r.URL.Query()["topic0"])
+
+		var from, to time.Time
+		for _, value := range r.URL.Query()["timestamp"] {
+			parsed, err := mirror.Timestamp(value[4:]).Time()
+			require.NoError(t, err)
+			if strings.HasPrefix(value, "gte:") {
+				from = parsed
+			} else {
+				to = parsed
+			}
+		}
+
+		mutex.Lock()
+		var selected []mirror.ContractLog
+		for _, log := range logs {
+			timestamp, _ := log.Timestamp.Time()
+			if !timestamp.Before(from) && !timestamp.After(to) {
+				selected = append(selected, log)
+			}
+		}
+		mutex.Unlock()
+
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{"logs": selected, "links": map[string]interface{}{}}))
+	}))
+	defer server.Close()
+
+	events := make(chan ContractEvent, 10)
+	checkpoints := make(chan ContractEventCheckpoint, 10)
+	handle, err := NewContractEventQuery().
+		SetContractID(ContractID{Contract: 1001}).
+		SetTopic(0, transferID[:], otherTopic).
+		SetABI(abi).
+		SetCheckpoint(ContractEventCheckpoint{Timestamp: base, Index: 0}).
+		SetCheckpointHandler(func(checkpoint ContractEventCheckpoint) {
+			checkpoints <- checkpoint
+		}).
+		SetPollInterval(10*time.Millisecond).
+		SetErrorHandler(func(err error) {
+			assert.NoError(t, err)
+		}).
+		Subscribe(_NewMirrorTestClient(t, server), func(event ContractEvent) {
+			events <- event
+		})
+	require.NoError(t, err)
+	defer handle.Unsubscribe()
+
+	// The log at the checkpoint isn't delivered again
+	event := <-events
+	assert.Equal(t, int64(1), event.Index)
+	assert.Equal(t, "Transfer", event.Event.Name)
+	assert.Equal(t, "0x0000000000000000000000000000000000000407", event.Values["to"].(Address).String())
+	assert.Equal(t, big.NewInt(100), event.Values["value"])
+	assert.Equal(t, []byte{1, 2}, event.TransactionHash)
+	assert.Equal(t, int64(7), event.BlockNumber)
+	assert.Equal(t, "0.0.1001", event.Log.ContractID.String())
+	assert.Equal(t, ContractEventCheckpoint{Timestamp: event.ConsensusTimestamp, Index: 1}, <-checkpoints)
+
+	// Logs of events missing from the ABI are delivered without being decoded
+	event = <-events
+	assert.Nil(t, event.Event)
+	assert.Equal(t, otherTopic, event.Log.Topics[0])
+	assert.True(t, base.Add(time.Second).Equal((<-checkpoints).Timestamp))
+
+	mutex.Lock()
+	logs = append(logs, newLog(2*time.Second, 0, transferID[:], _LogTestWord("0405"), _LogTestWord("0408")))
+	mutex.Unlock()
+
+	event = <-events
+	assert.Equal(t, "0x0000000000000000000000000000000000000408", event.Values["to"].(Address).String())
+
+	// Nothing is delivered twice while polling
+	time.Sleep(100 * time.Millisecond)
+	assert.Empty(t, events)
+}
+
+func TestUnitContractEventQuerySkipsImportedWindows(t *testing.T) {
+	t.Parallel()
+
+	var mutex sync.Mutex
+	var ranges []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/contracts/results/logs", r.URL.Path)
+		mutex.Lock()
+		ranges = append(ranges, strings.Join(r.URL.Query()["timestamp"], ","))
+		mutex.Unlock()
+		_, _ = w.Write([]byte(`{"logs":[],"links":{"next":null}}`))
+	}))
+	defer server.Close()
+
+	start := time.Now().Add(-10 * 24 * time.Hour)
+	handle, err := NewContractEventQuery().
+		SetStartTime(start).
+		SetPollInterval(time.Hour).
+		Subscribe(_NewMirrorTestClient(t, server), func(event ContractEvent) {})
+	require.NoError(t, err)
+	defer handle.Unsubscribe()
+
+	require.Eventually(t, func() bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return len(ranges) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	second := mirror.TimestampFromTime(start.Add(contractEventQueryMaxRange))
+	assert.Equal(t, "gte:"+string(mirror.TimestampFromTime(start))+",lte:"+string(second), ranges[0])
+	assert.True(t, strings.HasPrefix(ranges[1], "gte:"+string(second)+",lte:"))
+}
+
+type _ContractEventTestLogger struct {
+	Logger
+	mutex    sync.Mutex
+	warnings []string
+}
+
+func (logger *_ContractEventTestLogger) Warn(msg string, _ ...interface{}) {
+	logger.mutex.Lock()
+	defer logger.mutex.Unlock()
+	logger.warnings = append(logger.warnings, msg)
+}
+
+func TestUnitContractEventQueryLogsErrorsWithoutHandler(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"_status":{"messages":[{"message":"Invalid parameter"}]}}`))
+	}))
+	defer server.Close()
+
+	logger := &_ContractEventTestLogger{Logger: NewLogger("test", LoggerLevelError)}
+	client := _NewMirrorTestClient(t, server)
+	client.SetLogger(logger)
+
+	handle, err := NewContractEventQuery().
+		SetPollInterval(time.Hour).
+		Subscribe(client, func(event ContractEvent) {})
+	require.NoError(t, err)
+	defer handle.Unsubscribe()
+
+	require.Eventually(t, func() bool {
+		logger.mutex.Lock()
+		defer logger.mutex.Unlock()
+		return len(logger.warnings) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	logger.mutex.Lock()
+	defer logger.mutex.Unlock()
+	assert.Equal(t, "failed to poll contract logs", logger.warnings[0])
+}
+
+func TestUnitContractEventQueryValidate(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewContractEventQuery().Subscribe(nil, func(event ContractEvent) {})
+	require.Error(t, err)
+
+	client := ClientForNetwork(map[string]AccountID{})
+	_, err = NewContractEventQuery().SetPollInterval(0).Subscribe(client, func(event ContractEvent) {})
+	require.Error(t, err)
+
+	abi, err := NewABI(logTestABI)
+	require.NoError(t, err)
+	transferID := abi.Events["Transfer"].ID()
+	assert.Equal(t, [][]byte{transferID[:]}, NewContractEventQuery().SetEvents(abi.Events["Transfer"]).GetTopic(0))
+
+	assert.Panics(t, func() {
+		NewContractEventQuery().SetTopic(4, []byte{1})
+	})
+}
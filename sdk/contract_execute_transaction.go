@@ -106,6 +106,19 @@ func (tx *ContractExecuteTransaction) SetFunction(name string, params *ContractF
 	return tx
 }
 
+// SetFunctionFromABI sets the function to call from a method of an ABI and its arguments, which can be any Go values
+// the ABI encoder accepts, including structs for tuples and slices of them.
+func (tx *ContractExecuteTransaction) SetFunctionFromABI(method *Method, args ...interface{}) (*ContractExecuteTransaction, error) {
+	tx._RequireNotFrozen()
+	data, err := _EncodeCall(method, args)
+	if err != nil {
+		return tx, err
+	}
+
+	tx.parameters = data
+	return tx, nil
+}
+
 // ----------- Overridden functions ----------------
 
 func (tx ContractExecuteTransaction) getName() string {
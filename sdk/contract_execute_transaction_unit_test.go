@@ -7,6 +7,7 @@ package hiero
 
 import (
 	"bytes"
+	"math/big"
 	"strings"
 	"testing"
 	"time"
@@ -325,3 +326,47 @@ func TestUnitAddFunctionShouldErrorWithIncorrectLengthAddress(t *testing.T) {
 	require.NotNil(t, err)
 	assert.Equal(t, "address is required to be 40 characters", err.Error())
 }
+
+const abiCallTestABI = `[
+	{"type":"function","name":"setHoldings","stateMutability":"nonpayable","inputs":[
+		{"name":"holdings","type":"tuple[]","components":[
+			{"name":"owner","type":"address"},
+			{"name":"amounts","type":"uint256[]"}]},
+		{"name":"label","type":"string"}],"outputs":[]},
+	{"type":"function","name":"holding","stateMutability":"view","inputs":[],"outputs":[
+		{"name":"owner","type":"address"},
+		{"name":"amount","type":"uint256"}]},
+	{"type":"function","name":"total","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]}
+]`
+
+type abiCallTestHolding struct {
+	Owner   Address    `abi:"owner"`
+	Amounts []*big.Int `abi:"amounts"`
+}
+
+func TestUnitContractExecuteTransactionSetFunctionFromABI(t *testing.T) {
+	t.Parallel()
+
+	abi, err := NewABI(abiCallTestABI)
+	require.NoError(t, err)
+	method := abi.GetMethod("setHoldings")
+
+	holdings := []abiCallTestHolding{
+		{Owner: Address{1}, Amounts: []*big.Int{big.NewInt(1), big.NewInt(2)}},
+		{Owner: Address{2}, Amounts: []*big.Int{}},
+	}
+	tx, err := NewContractExecuteTransaction().SetFunctionFromABI(method, holdings, "label")
+	require.NoError(t, err)
+
+	expected, err := method.Encode([]interface{}{holdings, "label"})
+	require.NoError(t, err)
+	assert.Equal(t, expected, tx.GetFunctionParameters())
+	assert.Equal(t, method.ID(), tx.GetFunctionParameters()[:4])
+
+	_, err = NewContractExecuteTransaction().SetFunctionFromABI(method, holdings)
+	require.ErrorContains(t, err, "expects 2 arguments but got 1")
+	_, err = NewContractExecuteTransaction().SetFunctionFromABI(method, "not holdings", "label")
+	require.Error(t, err)
+	_, err = NewContractExecuteTransaction().SetFunctionFromABI(nil)
+	require.Error(t, err)
+}
@@ -4,10 +4,13 @@ package hiero
 
 import (
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"math/big"
+	"reflect"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/mitchellh/mapstructure"
 	protobuf "google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -494,6 +497,91 @@ func _ContractFunctionResultFromProtobuf(pb *services.ContractFunctionResult) Co
 	return result
 }
 
+// DecodeLogs decodes the logs of the result with the events of the ABI. Logs which match no event of the ABI, eg.
+// the ones emitted by other contracts the function called, are skipped.
+func (result ContractFunctionResult) DecodeLogs(abi *ABI) ([]DecodedLog, error) {
+	logs := make([]DecodedLog, 0, len(result.LogInfo))
+	for _, log := range result.LogInfo {
+		if len(log.Topics) == 0 {
+			continue
+		}
+		event := abi.GetEventByID(log.Topics[0])
+		if event == nil {
+			continue
+		}
+
+		values, err := event.ParseLog(log)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, DecodedLog{Event: event, Values: values, Log: log})
+	}
+
+	return logs, nil
+}
+
+// DecodeWith decodes the value returned by the method to out, which is a pointer to a struct whose fields are
+// matched with the outputs by their name or their abi tag. When the method has a single output, out can also be a
+// pointer to a variable of its type, and a single tuple output, eg. `returns (Point p)`, can be decoded to a pointer
+// to a struct whose fields match the components of the tuple. An error is returned when no field of the struct
+// matches.
+func (result ContractFunctionResult) DecodeWith(method *Method, out interface{}) error {
+	if method == nil {
+		return errors.New("method is required")
+	}
+
+	val, err := Decode(method.Outputs, result.ContractCallResult)
+	if err != nil {
+		return err
+	}
+	values := val.(map[string]interface{})
+
+	outputs := method.Outputs.TupleElems()
+	target := reflect.ValueOf(out)
+	if len(outputs) != 1 || target.Kind() != reflect.Ptr {
+		return _DecodeABIOutputs(values, out)
+	}
+
+	name := outputs[0].Name
+	if name == "" {
+		name = "0"
+	}
+	switch target.Elem().Kind() {
+	case reflect.Map:
+		return _DecodeABIMap(values, out)
+	case reflect.Struct:
+		// The struct either has a field named after the output, or holds the components of a tuple output
+		if err = _DecodeABIOutputs(values, out); err == nil || outputs[0].Elem.Kind() != KindTuple {
+			return err
+		}
+		return _DecodeABIOutputs(values[name].(map[string]interface{}), out)
+	default:
+		return _DecodeABIMap(values[name], out)
+	}
+}
+
+// _DecodeABIOutputs decodes the named values to out, failing when none of them matches a field of out
+func _DecodeABIOutputs(values map[string]interface{}, out interface{}) error {
+	var metadata mapstructure.Metadata
+	dc := &mapstructure.DecoderConfig{
+		Result:           out,
+		Metadata:         &metadata,
+		WeaklyTypedInput: true,
+		TagName:          "abi",
+	}
+	ms, err := mapstructure.NewDecoder(dc)
+	if err != nil {
+		return err
+	}
+	if err = ms.Decode(values); err != nil {
+		return err
+	}
+	if len(values) > 0 && len(metadata.Keys) == 0 && reflect.Indirect(reflect.ValueOf(out)).Kind() == reflect.Struct {
+		return fmt.Errorf("none of the outputs matches a field of %T", out)
+	}
+	return nil
+}
+
 func (result ContractFunctionResult) _ToProtobuf() *services.ContractFunctionResult {
 	infos := make([]*services.ContractLoginfo, len(result.LogInfo))
 
@@ -526,6 +614,23 @@ func (result *ContractFunctionResult) ToBytes() []byte {
 	return data
 }
 
+// MarshalJSON returns the protobuf JSON mapping of the proto.ContractFunctionResult message for the ContractFunctionResult.
+func (result ContractFunctionResult) MarshalJSON() ([]byte, error) {
+	return _ProtobufToJSON(result._ToProtobuf())
+}
+
+// UnmarshalJSON decodes a ContractFunctionResult from the protobuf JSON mapping of the proto.ContractFunctionResult message.
+func (result *ContractFunctionResult) UnmarshalJSON(data []byte) error {
+	pb := services.ContractFunctionResult{}
+	if err := _ProtobufFromJSON(data, &pb); err != nil {
+		return err
+	}
+
+	*result = _ContractFunctionResultFromProtobuf(&pb)
+
+	return nil
+}
+
 // ContractFunctionResultFromBytes returns a ContractFunctionResult from the protobuf encoded bytes of a ContractFunctionResult
 func ContractFunctionResultFromBytes(data []byte) (ContractFunctionResult, error) {
 	if data == nil {
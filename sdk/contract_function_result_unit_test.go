@@ -0,0 +1,82 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitContractFunctionResultDecodeWith(t *testing.T) {
+	t.Parallel()
+
+	abi, err := NewABI(abiCallTestABI)
+	require.NoError(t, err)
+
+	holding := abi.GetMethod("holding")
+	data, err := holding.Outputs.Encode(map[string]interface{}{"owner": Address{7}, "amount": big.NewInt(42)})
+	require.NoError(t, err)
+	result := ContractFunctionResult{ContractCallResult: data}
+
+	var out struct {
+		Owner  Address
+		Amount *big.Int `abi:"amount"`
+	}
+	require.NoError(t, result.DecodeWith(holding, &out))
+	assert.Equal(t, Address{7}, out.Owner)
+	assert.Equal(t, big.NewInt(42), out.Amount)
+
+	// A single output can be decoded to a variable of its type
+	total := abi.GetMethod("total")
+	data, err = total.Outputs.Encode([]interface{}{big.NewInt(9)})
+	require.NoError(t, err)
+	var value *big.Int
+	require.NoError(t, ContractFunctionResult{ContractCallResult: data}.DecodeWith(total, &value))
+	assert.Equal(t, big.NewInt(9), value)
+
+	require.Error(t, ContractFunctionResult{}.DecodeWith(total, &value))
+	require.Error(t, result.DecodeWith(nil, &out))
+}
+
+func TestUnitContractFunctionResultDecodeWithTuple(t *testing.T) {
+	t.Parallel()
+
+	abi, err := NewABI(`[{"type":"function","name":"point","stateMutability":"view","inputs":[],"outputs":[
+		{"name":"p","type":"tuple","components":[{"name":"x","type":"int64"},{"name":"y","type":"int64"}]}]}]`)
+	require.NoError(t, err)
+
+	method := abi.GetMethod("point")
+	data, err := method.Outputs.Encode([]interface{}{map[string]interface{}{"x": int64(3), "y": int64(-4)}})
+	require.NoError(t, err)
+	result := ContractFunctionResult{ContractCallResult: data}
+
+	// A single tuple output is decoded to a struct holding its components
+	var point struct {
+		X int64
+		Y int64
+	}
+	require.NoError(t, result.DecodeWith(method, &point))
+	assert.Equal(t, int64(3), point.X)
+	assert.Equal(t, int64(-4), point.Y)
+
+	// or to a struct with a field named after the output
+	var wrapped struct {
+		P struct {
+			X int64
+			Y int64
+		}
+	}
+	require.NoError(t, result.DecodeWith(method, &wrapped))
+	assert.Equal(t, point, wrapped.P)
+
+	var unrelated struct {
+		Z int64
+	}
+	require.ErrorContains(t, result.DecodeWith(method, &unrelated), "none of the outputs matches")
+}
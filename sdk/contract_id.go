@@ -3,14 +3,14 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"encoding/hex"
-	"encoding/json"
 	"fmt"
-	"net/http"
 	"strconv"
 	"strings"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/hiero-ledger/hiero-sdk-go/v2/sdk/mirror"
 	"github.com/pkg/errors"
 	protobuf "google.golang.org/protobuf/proto"
 )
@@ -157,37 +157,16 @@ func (id ContractID) ToSolidityAddress() string {
 // Should be used after generating `ContractId.FromEvmAddress()` because it sets the `Contract` field to `0`
 // automatically since there is no connection between the `Contract` and the `evmAddress`
 func (id *ContractID) PopulateContract(client *Client) error {
-	if client.mirrorNetwork == nil || len(client.GetMirrorNetwork()) == 0 {
-		return errors.New("mirror node is not set")
-	}
-	mirrorUrl := client.GetMirrorNetwork()[0]
-	index := strings.Index(mirrorUrl, ":")
-	if index == -1 {
-		return errors.New("invalid mirrorUrl format")
-	}
-	mirrorUrl = mirrorUrl[:index]
-	url := fmt.Sprintf("https://%s/api/v1/contracts/%s", mirrorUrl, hex.EncodeToString(id.EvmAddress))
-	if client.GetLedgerID().String() == "" {
-		url = fmt.Sprintf("http://%s:5551/api/v1/contracts/%s", mirrorUrl, hex.EncodeToString(id.EvmAddress))
-	}
-
-	resp, err := http.Get(url) // #nosec
-	if err != nil {
+	var contract *mirror.Contract
+	err := client._CallMirrorRest("5551", func(mirrorClient *mirror.Client) (err error) {
+		contract, err = mirrorClient.GetContract(context.Background(), hex.EncodeToString(id.EvmAddress))
 		return err
-	}
-	defer resp.Body.Close()
-
-	var result map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	})
+	if err != nil {
 		return err
 	}
 
-	mirrorContractId, ok := result["contract_id"].(string)
-	if !ok {
-		return errors.New("unexpected response format")
-	}
-
-	numStr := mirrorContractId[strings.LastIndex(mirrorContractId, ".")+1:]
+	numStr := contract.ContractID[strings.LastIndex(contract.ContractID, ".")+1:]
 	num, err := strconv.ParseInt(numStr, 10, 64)
 	if err != nil {
 		return err
@@ -253,6 +232,28 @@ func (id ContractID) ToBytes() []byte {
 	return data
 }
 
+// MarshalJSON implements the encoding.JSON interface.
+func (id ContractID) MarshalJSON() ([]byte, error) {
+	return _StringToJSON(id.String())
+}
+
+// UnmarshalJSON implements the encoding.JSON interface.
+func (id *ContractID) UnmarshalJSON(data []byte) error {
+	value, err := _StringFromJSON(data)
+	if err != nil {
+		return err
+	}
+
+	result, err := ContractIDFromString(value)
+	if err != nil {
+		return err
+	}
+
+	*id = result
+
+	return nil
+}
+
 // ContractIDFromBytes returns a ContractID generated from a byte array
 func ContractIDFromBytes(data []byte) (ContractID, error) {
 	pb := services.ContractID{}
@@ -119,6 +119,28 @@ func (contractInfo ContractInfo) ToBytes() []byte {
 	return data
 }
 
+// MarshalJSON returns the protobuf JSON mapping of the proto.ContractGetInfoResponse.ContractInfo message for the ContractInfo.
+func (contractInfo ContractInfo) MarshalJSON() ([]byte, error) {
+	return _ProtobufToJSON(contractInfo._ToProtobuf())
+}
+
+// UnmarshalJSON decodes a ContractInfo from the protobuf JSON mapping of the proto.ContractGetInfoResponse.ContractInfo message.
+func (contractInfo *ContractInfo) UnmarshalJSON(data []byte) error {
+	pb := services.ContractGetInfoResponse_ContractInfo{}
+	if err := _ProtobufFromJSON(data, &pb); err != nil {
+		return err
+	}
+
+	result, err := _ContractInfoFromProtobuf(&pb)
+	if err != nil {
+		return err
+	}
+
+	*contractInfo = result
+
+	return nil
+}
+
 // ContractInfoFromBytes returns a ContractInfo object deserialized from bytes
 func ContractInfoFromBytes(data []byte) (ContractInfo, error) {
 	if data == nil {
@@ -0,0 +1,159 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/sdk/mirror"
+)
+
+var (
+	// Selector of the Error(string) revert data emitted by require and revert with a message
+	revertErrorSelector = []byte{0x08, 0xc3, 0x79, 0xa0}
+	// Selector of the Panic(uint256) revert data emitted by failed assertions and runtime errors
+	revertPanicSelector = []byte{0x4e, 0x48, 0x7b, 0x71}
+)
+
+// Meaning of the codes of Panic(uint256), see https://docs.soliditylang.org/en/latest/control-structures.html#panic-via-assert-and-error-via-require
+var panicReasons = map[uint64]string{
+	0x00: "generic compiler inserted panic",
+	0x01: "assertion failed",
+	0x11: "arithmetic underflow or overflow",
+	0x12: "division or modulo by zero",
+	0x21: "conversion to an invalid enum value",
+	0x22: "access to an incorrectly encoded storage byte array",
+	0x31: "pop on an empty array",
+	0x32: "array index out of bounds",
+	0x41: "too much memory allocated",
+	0x51: "call to a zero-initialized internal function",
+}
+
+// ErrContractRevert is the decoded revert data of a contract call, which is either an Error(string) with a Reason,
+// a Panic(uint256) with a PanicCode or a custom error of an ABI with its arguments.
+type ErrContractRevert struct {
+	// Data is the raw revert data
+	Data []byte
+	// Name is "Error", "Panic" or the name of the custom error, and empty when the data couldn't be decoded
+	Name string
+	// Reason is the message of Error(string), or the meaning of the code of Panic(uint256)
+	Reason string
+	// PanicCode is the code of Panic(uint256)
+	PanicCode *big.Int
+	// CustomError is the error of the ABI matching the selector of the data
+	CustomError *Error
+	// Args are the arguments of the custom error, keyed by name or by position when unnamed
+	Args map[string]interface{}
+}
+
+// Error() implements the Error interface
+func (e ErrContractRevert) Error() string {
+	switch {
+	case e.Name == "Error":
+		return fmt.Sprintf("contract reverted: %s", e.Reason)
+	case e.Name == "Panic":
+		return fmt.Sprintf("contract panicked with code 0x%x: %s", e.PanicCode, e.Reason)
+	case e.CustomError != nil:
+		return fmt.Sprintf("contract reverted with %s%s", e.Name, e.CustomError.Inputs.FormatValue(e.Args))
+	case e.Reason != "":
+		return fmt.Sprintf("contract reverted: %s", e.Reason)
+	case len(e.Data) > 0:
+		return fmt.Sprintf("contract reverted with data 0x%x", e.Data)
+	default:
+		return "contract reverted without a reason"
+	}
+}
+
+// DecodeContractRevert decodes revert data as Error(string), Panic(uint256) or one of the errors of the ABI,
+// which can be nil. Data which can't be decoded is returned as is in ErrContractRevert.Data.
+func DecodeContractRevert(data []byte, abi *ABI) ErrContractRevert {
+	revert := ErrContractRevert{Data: data}
+	if len(data) < 4 {
+		return revert
+	}
+
+	selector, args := data[:4], data[4:]
+	switch {
+	case bytes.Equal(selector, revertErrorSelector):
+		typ, _ := NewType("tuple(string)")
+		if val, err := Decode(typ, args); err == nil {
+			revert.Name = "Error"
+			revert.Reason = val.(map[string]interface{})["0"].(string)
+		}
+
+	case bytes.Equal(selector, revertPanicSelector):
+		typ, _ := NewType("tuple(uint256)")
+		if val, err := Decode(typ, args); err == nil {
+			code := val.(map[string]interface{})["0"].(*big.Int)
+			revert.Name = "Panic"
+			revert.PanicCode = code
+			revert.Reason = "unknown panic code"
+			if code.IsUint64() {
+				if reason, ok := panicReasons[code.Uint64()]; ok {
+					revert.Reason = reason
+				}
+			}
+		}
+
+	case abi != nil:
+		for _, customError := range abi.Errors {
+			if !bytes.Equal(selector, customError.ID()) {
+				continue
+			}
+
+			values := map[string]interface{}{}
+			if len(customError.Inputs.TupleElems()) > 0 {
+				val, err := Decode(customError.Inputs, args)
+				if err != nil {
+					return revert
+				}
+				values = val.(map[string]interface{})
+			}
+
+			revert.Name = customError.Name
+			revert.CustomError = customError
+			revert.Args = values
+			break
+		}
+	}
+
+	return revert
+}
+
+// RevertReason decodes the error message of a reverted contract call with DecodeContractRevert. The abi is used
+// to decode custom errors and can be nil. It returns nil when the call didn't fail.
+func (result ContractFunctionResult) RevertReason(abi *ABI) *ErrContractRevert {
+	if result.ErrorMessage == "" {
+		return nil
+	}
+
+	return _RevertReasonFromHex(result.ErrorMessage, abi)
+}
+
+// MirrorNodeContractRevertReason decodes the revert data of the error returned by the Execute method of
+// MirrorNodeContractCallQuery or MirrorNodeContractEstimateGasQuery, like ContractFunctionResult.RevertReason.
+// It returns nil when the error wasn't caused by a reverted call.
+func MirrorNodeContractRevertReason(err error, abi *ABI) *ErrContractRevert {
+	var mirrorErr *mirror.Error
+	if !errors.As(err, &mirrorErr) || mirrorErr.Data == "" {
+		return nil
+	}
+
+	return _RevertReasonFromHex(mirrorErr.Data, abi)
+}
+
+// _RevertReasonFromHex decodes hex encoded revert data, or returns a message which isn't hex encoded as the reason
+func _RevertReasonFromHex(message string, abi *ABI) *ErrContractRevert {
+	data, err := hex.DecodeString(strings.TrimPrefix(message, "0x"))
+	if err != nil {
+		return &ErrContractRevert{Reason: message}
+	}
+
+	revert := DecodeContractRevert(data, abi)
+	return &revert
+}
@@ -0,0 +1,114 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/hex"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const revertTestABI = `[
+	{"type":"error","name":"InsufficientBalance","inputs":[
+		{"name":"available","type":"uint256"},
+		{"name":"required","type":"uint256"}]},
+	{"type":"error","name":"Unauthorized","inputs":[]}
+]`
+
+func TestUnitContractRevertErrorString(t *testing.T) {
+	t.Parallel()
+
+	// Error("Not enough")
+	data := "08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"000000000000000000000000000000000000000000000000000000000000000a" +
+		"4e6f7420656e6f75676800000000000000000000000000000000000000000000"
+
+	revert := ContractFunctionResult{ErrorMessage: "0x" + data}.RevertReason(nil)
+	require.NotNil(t, revert)
+	assert.Equal(t, "Error", revert.Name)
+	assert.Equal(t, "Not enough", revert.Reason)
+	assert.Equal(t, "contract reverted: Not enough", revert.Error())
+
+	assert.Nil(t, ContractFunctionResult{}.RevertReason(nil))
+}
+
+func TestUnitContractRevertPanic(t *testing.T) {
+	t.Parallel()
+
+	data, err := hex.DecodeString("4e487b71" + hex.EncodeToString(_LogTestWord("11")))
+	require.NoError(t, err)
+
+	revert := DecodeContractRevert(data, nil)
+	assert.Equal(t, "Panic", revert.Name)
+	assert.Equal(t, big.NewInt(0x11), revert.PanicCode)
+	assert.Equal(t, "contract panicked with code 0x11: arithmetic underflow or overflow", revert.Error())
+
+	data, err = hex.DecodeString("4e487b71" + hex.EncodeToString(_LogTestWord("99")))
+	require.NoError(t, err)
+	assert.Equal(t, "unknown panic code", DecodeContractRevert(data, nil).Reason)
+}
+
+func TestUnitContractRevertCustomError(t *testing.T) {
+	t.Parallel()
+
+	abi, err := NewABI(revertTestABI)
+	require.NoError(t, err)
+
+	insufficient := abi.Errors["InsufficientBalance"]
+	assert.Equal(t, "InsufficientBalance(uint256,uint256)", insufficient.Sig())
+	assert.Equal(t, "cf479181", hex.EncodeToString(insufficient.ID()))
+
+	data := append(insufficient.ID(), append(_LogTestWord("01"), _LogTestWord("02")...)...)
+	revert := DecodeContractRevert(data, abi)
+	assert.Equal(t, "InsufficientBalance", revert.Name)
+	assert.Equal(t, insufficient, revert.CustomError)
+	assert.Equal(t, big.NewInt(2), revert.Args["required"])
+	assert.Equal(t, "contract reverted with InsufficientBalance(available: 1, required: 2)", revert.Error())
+
+	revert = DecodeContractRevert(abi.Errors["Unauthorized"].ID(), abi)
+	assert.Equal(t, "contract reverted with Unauthorized()", revert.Error())
+
+	// Without the ABI, custom errors are left undecoded
+	revert = DecodeContractRevert(data, nil)
+	assert.Empty(t, revert.Name)
+	assert.Equal(t, "contract reverted with data 0x"+hex.EncodeToString(data), revert.Error())
+
+	revert = *ContractFunctionResult{ErrorMessage: "INSUFFICIENT_GAS"}.RevertReason(abi)
+	assert.Equal(t, "contract reverted: INSUFFICIENT_GAS", revert.Error())
+	assert.Equal(t, "contract reverted without a reason", DecodeContractRevert(nil, abi).Error())
+}
+
+func TestUnitMirrorNodeContractRevertReason(t *testing.T) {
+	t.Parallel()
+
+	abi, err := NewABI(revertTestABI)
+	require.NoError(t, err)
+	data := "0x" + hex.EncodeToString(abi.Errors["Unauthorized"].ID())
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"_status":{"messages":[{"message":"CONTRACT_REVERT_EXECUTED","detail":"","data":"` + data + `"}]}}`))
+	}))
+	defer server.Close()
+
+	_, err = NewMirrorNodeContractCallQuery().
+		SetContractID(ContractID{Contract: 1001}).
+		SetFunctionParameters([]byte{1, 2, 3, 4}).
+		Execute(_NewMirrorTestClient(t, server))
+	require.Error(t, err)
+
+	revert := MirrorNodeContractRevertReason(err, abi)
+	require.NotNil(t, revert)
+	assert.Equal(t, "Unauthorized", revert.Name)
+
+	assert.Nil(t, MirrorNodeContractRevertReason(errNoClientProvided, abi))
+}
@@ -319,6 +319,10 @@ func (tx ContractUpdateTransaction) getName() string {
 	return "ContractUpdateTransaction"
 }
 
+func (tx ContractUpdateTransaction) validateBody() []ErrTransactionValidation {
+	return _ValidateMemo("contract memo", tx.memo)
+}
+
 func (tx ContractUpdateTransaction) validateNetworkOnIDs(client *Client) error {
 	if client == nil || !client.autoValidateChecksums {
 		return nil
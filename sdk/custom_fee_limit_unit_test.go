@@ -11,6 +11,7 @@ import (
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	protobuf "google.golang.org/protobuf/proto"
 )
 
 func TestUnitCustomFeeLimitSetPayerId(t *testing.T) {
@@ -99,3 +100,55 @@ func TestUnitCustomFeeLimitString(t *testing.T) {
 	expected := "CustomFeeLimit{PayerId: " + payerId.String() + ", CustomFees: [" + customFee.String() + "]}"
 	assert.Equal(t, expected, feeLimit.String())
 }
+
+func _NewCustomFeeLimitTestTransaction(t *testing.T) *TopicMessageSubmitTransaction {
+	limit := NewCustomFeeLimit().
+		SetPayerId(AccountID{Account: 2}).
+		AddCustomFee(NewCustomFixedFee().SetAmount(1))
+
+	tx, err := NewTopicMessageSubmitTransaction().
+		SetTopicID(TopicID{Topic: 5}).
+		SetMessage([]byte("message")).
+		AddCustomFeeLimit(limit).
+		SetTransactionID(testTransactionID).
+		SetNodeAccountIDs([]AccountID{{Account: 3}, {Account: 4}, {Account: 5}}).
+		Freeze()
+	require.NoError(t, err)
+
+	return tx
+}
+
+func TestUnitCustomFeeLimitFromBytesReadsFirstBody(t *testing.T) {
+	t.Parallel()
+
+	data, err := _NewCustomFeeLimitTestTransaction(t).ToBytes()
+	require.NoError(t, err)
+
+	// Every node body holds the limits, which are only read once
+	parsed, err := TransactionFromBytes(data)
+	require.NoError(t, err)
+	parsedTx := parsed.(TopicMessageSubmitTransaction)
+	assert.Len(t, parsedTx.GetCustomFeeLimits(), 1)
+
+	reserialized, err := TransactionToBytes(parsed)
+	require.NoError(t, err)
+	assert.Equal(t, data, reserialized)
+}
+
+func TestUnitCustomFeeLimitNotDuplicatedOnRebuild(t *testing.T) {
+	t.Parallel()
+
+	tx := _NewCustomFeeLimitTestTransaction(t)
+	for _, fee := range []uint64{100, 200} {
+		tx.transactionFee = fee
+		_, err := tx.ToBytes()
+		require.NoError(t, err)
+	}
+
+	for i := 0; i < tx.signedTransactions._Length(); i++ {
+		body := services.TransactionBody{}
+		require.NoError(t, protobuf.Unmarshal(tx.signedTransactions._Get(i).(*services.SignedTransaction).BodyBytes, &body))
+		assert.Equal(t, uint64(200), body.TransactionFee)
+		assert.Len(t, body.MaxCustomFees, 1)
+	}
+}
@@ -200,6 +200,28 @@ func (id DelegatableContractID) ToBytes() []byte {
 	return data
 }
 
+// MarshalJSON implements the encoding.JSON interface.
+func (id DelegatableContractID) MarshalJSON() ([]byte, error) {
+	return _StringToJSON(id.String())
+}
+
+// UnmarshalJSON implements the encoding.JSON interface.
+func (id *DelegatableContractID) UnmarshalJSON(data []byte) error {
+	value, err := _StringFromJSON(data)
+	if err != nil {
+		return err
+	}
+
+	result, err := DelegatableContractIDFromString(value)
+	if err != nil {
+		return err
+	}
+
+	*id = result
+
+	return nil
+}
+
 // DelegatableContractIDFromBytes returns a DelegatableContractID generated from a byte array
 func DelegatableContractIDFromBytes(data []byte) (DelegatableContractID, error) {
 	pb := services.ContractID{}
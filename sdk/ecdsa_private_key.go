@@ -212,6 +212,12 @@ func (sk _ECDSAPrivateKey) _Sign(message []byte) []byte {
 	return ecdsa.SignCompact(sk.keyData, hash.Bytes(), true)
 }
 
+// _SignRecoverable signs a 32 bytes hash as is, like Ethereum does, and returns r, s and the recovery id (0 or 1)
+func (sk _ECDSAPrivateKey) _SignRecoverable(hash []byte) ([]byte, []byte, byte) {
+	signature := ecdsa.SignCompact(sk.keyData, hash, false)
+	return signature[1:33], signature[33:65], signature[0] - 27
+}
+
 // SupportsDerivation returns true if the _ECDSAPrivateKey supports derivation.
 func (sk _ECDSAPrivateKey) _SupportsDerivation() bool {
 	return sk.chainCode != nil
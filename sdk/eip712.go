@@ -0,0 +1,460 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+)
+
+// Fields of the EIP712Domain type, in the order they are encoded when the typed data doesn't declare the type
+var eip712DomainFields = []EIP712Field{
+	{Name: "name", Type: "string"},
+	{Name: "version", Type: "string"},
+	{Name: "chainId", Type: "uint256"},
+	{Name: "verifyingContract", Type: "address"},
+	{Name: "salt", Type: "bytes32"},
+}
+
+var eip712ArrayRegexp = regexp.MustCompile(`^(.*)\[(\d*)\]$`)
+
+// EIP712Field is a member of a struct type of EIP-712 typed data
+type EIP712Field struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// EIP712TypedData is EIP-712 typed structured data, as passed to eth_signTypedData_v4. The values of the domain and
+// the message can be the ones decoded from JSON (strings, json.Number, bools, maps and slices) or Go values like
+// *big.Int, Address, []byte and [N]byte.
+type EIP712TypedData struct {
+	Types       map[string][]EIP712Field `json:"types"`
+	PrimaryType string                   `json:"primaryType"`
+	Domain      map[string]interface{}   `json:"domain"`
+	Message     map[string]interface{}   `json:"message"`
+}
+
+// EIP712Signature is a secp256k1 signature of typed data in the Ethereum format, where V is 27 or 28
+type EIP712Signature struct {
+	R [32]byte
+	S [32]byte
+	V uint8
+}
+
+// Bytes returns the 65 bytes r || s || v form of the signature expected by ecrecover based contracts
+func (sig EIP712Signature) Bytes() []byte {
+	return append(append(append([]byte{}, sig.R[:]...), sig.S[:]...), sig.V)
+}
+
+// String returns the hex encoded bytes of the signature
+func (sig EIP712Signature) String() string {
+	return "0x" + hex.EncodeToString(sig.Bytes())
+}
+
+// EIP712TypedDataFromJSON parses typed data from its JSON form
+func EIP712TypedDataFromJSON(data []byte) (*EIP712TypedData, error) {
+	var typedData EIP712TypedData
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&typedData); err != nil {
+		return nil, err
+	}
+
+	if typedData.PrimaryType == "" {
+		return nil, fmt.Errorf("typed data has no primary type")
+	}
+
+	return &typedData, nil
+}
+
+// EncodeType returns the encoding of a struct type, which is its signature followed by the signatures of the
+// struct types it references, sorted by name
+func (typedData *EIP712TypedData) EncodeType(typeName string) (string, error) {
+	deps := map[string]bool{}
+	if err := typedData._Dependencies(typeName, deps); err != nil {
+		return "", err
+	}
+	delete(deps, typeName)
+
+	names := make([]string, 0, len(deps))
+	for name := range deps {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var encoded strings.Builder
+	for _, name := range append([]string{typeName}, names...) {
+		fields := typedData._Fields(name)
+		members := make([]string, 0, len(fields))
+		for _, field := range fields {
+			members = append(members, field.Type+" "+field.Name)
+		}
+		encoded.WriteString(name + "(" + strings.Join(members, ",") + ")")
+	}
+
+	return encoded.String(), nil
+}
+
+// TypeHash returns the keccak256 hash of the encoding of a struct type
+func (typedData *EIP712TypedData) TypeHash(typeName string) (Hash, error) {
+	encoded, err := typedData.EncodeType(typeName)
+	if err != nil {
+		return Hash{}, err
+	}
+
+	return Keccak256Hash([]byte(encoded)), nil
+}
+
+// HashStruct returns hashStruct(value) for a value of a struct type
+func (typedData *EIP712TypedData) HashStruct(typeName string, value map[string]interface{}) (Hash, error) {
+	encoded, err := typedData._EncodeData(typeName, value)
+	if err != nil {
+		return Hash{}, err
+	}
+
+	return Keccak256Hash(encoded), nil
+}
+
+// DomainSeparator returns the hash of the domain. The EIP712Domain type is derived from the fields of the domain
+// when the typed data doesn't declare it.
+func (typedData *EIP712TypedData) DomainSeparator() (Hash, error) {
+	return typedData.HashStruct("EIP712Domain", typedData.Domain)
+}
+
+// Hash returns the digest which is signed, keccak256("\x19\x01" || domainSeparator || hashStruct(message))
+func (typedData *EIP712TypedData) Hash() (Hash, error) {
+	domainSeparator, err := typedData.DomainSeparator()
+	if err != nil {
+		return Hash{}, err
+	}
+
+	messageHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	if err != nil {
+		return Hash{}, err
+	}
+
+	return Keccak256Hash(append(append([]byte{0x19, 0x01}, domainSeparator[:]...), messageHash[:]...)), nil
+}
+
+// Sign signs the typed data with a secp256k1 private key
+func (typedData *EIP712TypedData) Sign(key PrivateKey) (EIP712Signature, error) {
+	if key.ecdsaPrivateKey == nil {
+		return EIP712Signature{}, _NewErrBadKeyf("typed data can only be signed with an ECDSA secp256k1 key")
+	}
+
+	digest, err := typedData.Hash()
+	if err != nil {
+		return EIP712Signature{}, err
+	}
+
+	r, s, recoveryID := key.ecdsaPrivateKey._SignRecoverable(digest[:])
+
+	signature := EIP712Signature{V: 27 + recoveryID}
+	copy(signature.R[:], r)
+	copy(signature.S[:], s)
+	return signature, nil
+}
+
+// RecoverSigner returns the address of the account which signed the typed data. The signature is in the
+// r || s || v form, where v is either 0/1 or 27/28.
+func (typedData *EIP712TypedData) RecoverSigner(signature []byte) (Address, error) {
+	if len(signature) != 65 {
+		return Address{}, fmt.Errorf("signature must be 65 bytes long, got %d", len(signature))
+	}
+
+	recoveryID := signature[64]
+	if recoveryID >= 27 {
+		recoveryID -= 27
+	}
+
+	digest, err := typedData.Hash()
+	if err != nil {
+		return Address{}, err
+	}
+
+	return _RecoverEthereumAddress(digest[:], signature[:32], signature[32:64], recoveryID)
+}
+
+// Verify reports whether the typed data was signed by the account with the given address
+func (typedData *EIP712TypedData) Verify(signature []byte, address Address) bool {
+	signer, err := typedData.RecoverSigner(signature)
+	return err == nil && signer == address
+}
+
+func (typedData *EIP712TypedData) _Fields(typeName string) []EIP712Field {
+	if fields, ok := typedData.Types[typeName]; ok {
+		return fields
+	}
+	if typeName != "EIP712Domain" {
+		return nil
+	}
+
+	var fields []EIP712Field
+	for _, field := range eip712DomainFields {
+		if _, ok := typedData.Domain[field.Name]; ok {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+func (typedData *EIP712TypedData) _IsStruct(typeName string) bool {
+	if _, ok := typedData.Types[typeName]; ok {
+		return true
+	}
+	return typeName == "EIP712Domain"
+}
+
+func (typedData *EIP712TypedData) _Dependencies(typeName string, deps map[string]bool) error {
+	if deps[typeName] {
+		return nil
+	}
+	if !typedData._IsStruct(typeName) {
+		return fmt.Errorf("unknown struct type %s", typeName)
+	}
+	deps[typeName] = true
+
+	for _, field := range typedData._Fields(typeName) {
+		baseType := field.Type
+		for {
+			match := eip712ArrayRegexp.FindStringSubmatch(baseType)
+			if match == nil {
+				break
+			}
+			baseType = match[1]
+		}
+
+		if typedData._IsStruct(baseType) {
+			if err := typedData._Dependencies(baseType, deps); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// _EncodeData returns typeHash || encodeData(value)
+func (typedData *EIP712TypedData) _EncodeData(typeName string, value map[string]interface{}) ([]byte, error) {
+	typeHash, err := typedData.TypeHash(typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	encoded := append([]byte{}, typeHash[:]...)
+	for _, field := range typedData._Fields(typeName) {
+		fieldValue, ok := value[field.Name]
+		if !ok {
+			return nil, fmt.Errorf("missing value of %s.%s", typeName, field.Name)
+		}
+
+		word, err := typedData._EncodeValue(field.Type, fieldValue)
+		if err != nil {
+			return nil, fmt.Errorf("encoding %s.%s: %w", typeName, field.Name, err)
+		}
+		encoded = append(encoded, word...)
+	}
+
+	return encoded, nil
+}
+
+// _EncodeValue returns the 32 bytes encoding of a member. Structs, arrays, strings and bytes are encoded as the
+// keccak256 hash of their contents.
+func (typedData *EIP712TypedData) _EncodeValue(typeName string, value interface{}) ([]byte, error) {
+	if match := eip712ArrayRegexp.FindStringSubmatch(typeName); match != nil {
+		items := reflect.ValueOf(value)
+		if items.Kind() != reflect.Slice && items.Kind() != reflect.Array {
+			return nil, fmt.Errorf("expected an array for %s but got %T", typeName, value)
+		}
+		if match[2] != "" {
+			size, _ := strconv.Atoi(match[2])
+			if items.Len() != size {
+				return nil, fmt.Errorf("expected %d items for %s but got %d", size, typeName, items.Len())
+			}
+		}
+
+		var encoded []byte
+		for i := 0; i < items.Len(); i++ {
+			word, err := typedData._EncodeValue(match[1], items.Index(i).Interface())
+			if err != nil {
+				return nil, err
+			}
+			encoded = append(encoded, word...)
+		}
+		hash := Keccak256Hash(encoded)
+		return hash[:], nil
+	}
+
+	if typedData._IsStruct(typeName) {
+		fields, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected a map for struct %s but got %T", typeName, value)
+		}
+		hash, err := typedData.HashStruct(typeName, fields)
+		return hash[:], err
+	}
+
+	switch {
+	case typeName == "string":
+		str, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a string but got %T", value)
+		}
+		hash := Keccak256Hash([]byte(str))
+		return hash[:], nil
+
+	case typeName == "bytes":
+		data, err := _EIP712Bytes(value)
+		if err != nil {
+			return nil, err
+		}
+		hash := Keccak256Hash(data)
+		return hash[:], nil
+
+	case typeName == "bool":
+		flag, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("expected a bool but got %T", value)
+		}
+		if flag {
+			return leftPad([]byte{1}, 32), nil
+		}
+		return make([]byte, 32), nil
+
+	case typeName == "address":
+		var address Address
+		switch v := value.(type) {
+		case Address:
+			address = v
+		case string:
+			if err := address.UnmarshalText([]byte(v)); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("expected an address but got %T", value)
+		}
+		return leftPad(address[:], 32), nil
+
+	case strings.HasPrefix(typeName, "bytes"):
+		size, err := strconv.Atoi(strings.TrimPrefix(typeName, "bytes"))
+		if err != nil || size < 1 || size > 32 {
+			return nil, fmt.Errorf("unknown type %s", typeName)
+		}
+		data, err := _EIP712Bytes(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(data) != size {
+			return nil, fmt.Errorf("expected %d bytes for %s but got %d", size, typeName, len(data))
+		}
+		return rightPad(data, 32), nil
+
+	case strings.HasPrefix(typeName, "uint"), strings.HasPrefix(typeName, "int"):
+		unsigned := strings.HasPrefix(typeName, "uint")
+		bits := 256
+		if suffix := strings.TrimPrefix(strings.TrimPrefix(typeName, "u"), "int"); suffix != "" {
+			var err error
+			if bits, err = strconv.Atoi(suffix); err != nil || bits < 8 || bits > 256 || bits%8 != 0 {
+				return nil, fmt.Errorf("unknown type %s", typeName)
+			}
+		}
+
+		number, err := _EIP712Int(value)
+		if err != nil {
+			return nil, err
+		}
+		if unsigned && number.Sign() < 0 {
+			return nil, fmt.Errorf("negative value %s for %s", number, typeName)
+		}
+
+		// Values must fit the width of the type, like abi.encode requires
+		limit := new(big.Int).Lsh(big.NewInt(1), uint(bits))
+		if !unsigned {
+			limit.Rsh(limit, 1)
+		}
+		if number.Cmp(limit) >= 0 || (!unsigned && number.Cmp(new(big.Int).Neg(limit)) < 0) {
+			return nil, fmt.Errorf("value %s overflows %s", number, typeName)
+		}
+		return toU256(number), nil
+
+	default:
+		return nil, fmt.Errorf("unknown type %s", typeName)
+	}
+}
+
+func _EIP712Bytes(value interface{}) ([]byte, error) {
+	switch v := value.(type) {
+	case []byte:
+		return v, nil
+	case string:
+		return decodeHex(v)
+	}
+
+	array := reflect.ValueOf(value)
+	if array.Kind() == reflect.Array && array.Type().Elem().Kind() == reflect.Uint8 {
+		return convertArrayToBytes(array).Bytes(), nil
+	}
+
+	return nil, fmt.Errorf("expected bytes but got %T", value)
+}
+
+func _EIP712Int(value interface{}) (*big.Int, error) {
+	switch v := value.(type) {
+	case *big.Int:
+		return v, nil
+	case json.Number:
+		return _EIP712Int(string(v))
+	case string:
+		number, ok := new(big.Int), false
+		if strings.HasPrefix(v, "0x") {
+			number, ok = number.SetString(v[2:], 16)
+		} else {
+			number, ok = number.SetString(v, 10)
+		}
+		if !ok {
+			return nil, fmt.Errorf("invalid number %q", v)
+		}
+		return number, nil
+	case float64:
+		if v != float64(int64(v)) {
+			return nil, fmt.Errorf("invalid integer %v", v)
+		}
+		return big.NewInt(int64(v)), nil
+	}
+
+	number := reflect.ValueOf(value)
+	switch number.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return big.NewInt(number.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Int).SetUint64(number.Uint()), nil
+	default:
+		return nil, fmt.Errorf("expected an integer but got %T", value)
+	}
+}
+
+// _RecoverEthereumAddress returns the address of the key which signed the hash, from the r, s and recovery id
+// (0 or 1) of the signature
+func _RecoverEthereumAddress(hash []byte, r []byte, s []byte, recoveryID byte) (Address, error) {
+	if recoveryID > 1 {
+		return Address{}, fmt.Errorf("invalid recovery id %d", recoveryID)
+	}
+
+	compact := append(append([]byte{27 + recoveryID}, r...), s...)
+	publicKey, _, err := ecdsa.RecoverCompact(compact, hash)
+	if err != nil {
+		return Address{}, err
+	}
+
+	return BytesToAddress(Keccak256Hash(publicKey.SerializeUncompressed()[1:]).Bytes()), nil
+}
@@ -0,0 +1,195 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The example of the EIP-712 specification
+const eip712MailJSON = `{
+	"types": {
+		"EIP712Domain": [
+			{"name": "name", "type": "string"},
+			{"name": "version", "type": "string"},
+			{"name": "chainId", "type": "uint256"},
+			{"name": "verifyingContract", "type": "address"}
+		],
+		"Person": [
+			{"name": "name", "type": "string"},
+			{"name": "wallet", "type": "address"}
+		],
+		"Mail": [
+			{"name": "from", "type": "Person"},
+			{"name": "to", "type": "Person"},
+			{"name": "contents", "type": "string"}
+		]
+	},
+	"primaryType": "Mail",
+	"domain": {
+		"name": "Ether Mail",
+		"version": "1",
+		"chainId": 1,
+		"verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"
+	},
+	"message": {
+		"from": {"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+		"to": {"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+		"contents": "Hello, Bob!"
+	}
+}`
+
+func _EIP712HexHash(t *testing.T, hash Hash, err error) string {
+	require.NoError(t, err)
+	return hex.EncodeToString(hash[:])
+}
+
+func TestUnitEIP712Hash(t *testing.T) {
+	t.Parallel()
+
+	typedData, err := EIP712TypedDataFromJSON([]byte(eip712MailJSON))
+	require.NoError(t, err)
+
+	encoded, err := typedData.EncodeType("Mail")
+	require.NoError(t, err)
+	assert.Equal(t, "Mail(Person from,Person to,string contents)Person(string name,address wallet)", encoded)
+
+	hash, err := typedData.TypeHash("Mail")
+	assert.Equal(t, "a0cedeb2dc280ba39b857546d74f5549c3a1d7bdc2dd96bf881f76108e23dac2", _EIP712HexHash(t, hash, err))
+
+	hash, err = typedData.DomainSeparator()
+	assert.Equal(t, "f2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f", _EIP712HexHash(t, hash, err))
+
+	hash, err = typedData.HashStruct("Mail", typedData.Message)
+	assert.Equal(t, "c52c0ee5d84264471806290a3f2c4cecfc5490626bf912d01f240d7a274b371e", _EIP712HexHash(t, hash, err))
+
+	hash, err = typedData.Hash()
+	assert.Equal(t, "be609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2", _EIP712HexHash(t, hash, err))
+
+	// The EIP712Domain type is derived from the domain when it isn't declared
+	delete(typedData.Types, "EIP712Domain")
+	hash, err = typedData.DomainSeparator()
+	assert.Equal(t, "f2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f", _EIP712HexHash(t, hash, err))
+}
+
+func TestUnitEIP712SignAndRecover(t *testing.T) {
+	t.Parallel()
+
+	typedData, err := EIP712TypedDataFromJSON([]byte(eip712MailJSON))
+	require.NoError(t, err)
+
+	// keccak256("cow")
+	key, err := PrivateKeyFromStringECDSA("c85ef7d79691fe79573b1a7064c19c1a9819ebdbd1faaab1a8ec92344438aaf4")
+	require.NoError(t, err)
+
+	signature, err := typedData.Sign(key)
+	require.NoError(t, err)
+	assert.Equal(t, uint8(28), signature.V)
+	assert.Equal(t, "4355c47d63924e8a72e509b65029052eb6c299d53a04e167c5775fd466751c9d", hex.EncodeToString(signature.R[:]))
+	assert.Equal(t, "07299936d304c153f6443dfa05f40ff007d72911b6f72307f996231605b91562", hex.EncodeToString(signature.S[:]))
+
+	signer, err := typedData.RecoverSigner(signature.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826", signer.String())
+	assert.True(t, typedData.Verify(signature.Bytes(), signer))
+
+	typedData.Message["contents"] = "Hello, Alice!"
+	assert.False(t, typedData.Verify(signature.Bytes(), signer))
+
+	_, err = typedData.RecoverSigner(signature.Bytes()[:64])
+	require.Error(t, err)
+
+	ed25519Key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	_, err = typedData.Sign(ed25519Key)
+	require.Error(t, err)
+}
+
+func TestUnitEIP712ArraysAndGoValues(t *testing.T) {
+	t.Parallel()
+
+	typedData := &EIP712TypedData{
+		Types: map[string][]EIP712Field{
+			"Item":  {{Name: "id", Type: "uint64"}, {Name: "tags", Type: "bytes32[2]"}},
+			"Order": {{Name: "items", Type: "Item[]"}, {Name: "amount", Type: "int256"}, {Name: "data", Type: "bytes"}},
+		},
+		PrimaryType: "Order",
+		Domain:      map[string]interface{}{"name": "Shop", "chainId": big.NewInt(296)},
+		Message: map[string]interface{}{
+			"items": []interface{}{
+				map[string]interface{}{"id": uint64(1), "tags": [][32]byte{{1}, {2}}},
+			},
+			"amount": big.NewInt(-5),
+			"data":   []byte{1, 2, 3},
+		},
+	}
+
+	encoded, err := typedData.EncodeType("Order")
+	require.NoError(t, err)
+	assert.Equal(t, "Order(Item[] items,int256 amount,bytes data)Item(uint64 id,bytes32[2] tags)", encoded)
+
+	item := Keccak256Hash(append(append(Keccak256Hash([]byte("Item(uint64 id,bytes32[2] tags)")).Bytes(),
+		leftPad([]byte{1}, 32)...), Keccak256Hash(append(rightPad([]byte{1}, 32), rightPad([]byte{2}, 32)...)).Bytes()...))
+	expected := Keccak256Hash(append(append(append(Keccak256Hash([]byte(encoded)).Bytes(),
+		Keccak256Hash(item[:]).Bytes()...), toU256(big.NewInt(-5))...), Keccak256Hash([]byte{1, 2, 3}).Bytes()...))
+	hash, err := typedData.HashStruct("Order", typedData.Message)
+	require.NoError(t, err)
+	assert.Equal(t, expected, hash)
+
+	_, err = typedData.DomainSeparator()
+	require.NoError(t, err)
+
+	typedData.Message["items"] = []interface{}{map[string]interface{}{"id": uint64(1), "tags": [][32]byte{{1}}}}
+	_, err = typedData.Hash()
+	require.ErrorContains(t, err, "expected 2 items")
+
+	typedData.Types["Order"][1].Type = "uint8x"
+	_, err = typedData.HashStruct("Order", typedData.Message)
+	require.Error(t, err)
+}
+
+func TestUnitEIP712IntegerWidth(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		typeName string
+		value    interface{}
+		valid    bool
+	}{
+		{"uint8", 255, true},
+		{"uint8", 256, false},
+		{"uint8", -1, false},
+		{"int8", 127, true},
+		{"int8", -128, true},
+		{"int8", 128, false},
+		{"int8", -129, false},
+		{"uint", new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1)), true},
+		{"uint256", new(big.Int).Lsh(big.NewInt(1), 256), false},
+		{"int64", "9223372036854775808", false},
+		{"uint7", 1, false},
+		{"uint264", 1, false},
+	}
+
+	for _, test := range tests {
+		typedData := &EIP712TypedData{
+			Types:       map[string][]EIP712Field{"Value": {{Name: "value", Type: test.typeName}}},
+			PrimaryType: "Value",
+			Message:     map[string]interface{}{"value": test.value},
+		}
+
+		_, err := typedData.HashStruct("Value", typedData.Message)
+		if test.valid {
+			assert.NoError(t, err, "%s %v", test.typeName, test.value)
+		} else {
+			assert.Error(t, err, "%s %v", test.typeName, test.value)
+		}
+	}
+}
@@ -23,6 +23,7 @@ var errClientOperatorSigning = errors.New("`client` must have an `_Operator` to
 var errNoClientProvided = errors.New("`client` must be provided and have an _Operator")
 var errTransactionIsNotFrozen = errors.New("transaction is not frozen")
 var errFailedToDeserializeBytes = errors.New("failed to deserialize bytes")
+var errUnrecognizedTransactionType = errors.New("unrecognized transaction type")
 var errNoTransactionInBytes = errors.New("no transaction was found in bytes")
 var errTransactionRequiresSingleNodeAccountID = errors.New("`PrivateKey.SignTransaction()` requires `Transaction` to have a single _Node `AccountID` set")
 var errNoTransactions = errors.New("no transactions to execute")
@@ -32,6 +33,7 @@ var errNetworkNameMissing = errors.New("can't derive checksum for ID without kno
 var errChecksumMissing = errors.New("no checksum provided")
 var errLockedSlice = errors.New("slice is locked")
 var errNodeIsUnhealthy = errors.New("node is unhealthy")
+var errEthereumTransactionDataEmpty = errors.New("ethereum transaction data is empty")
 
 type ErrInvalidNodeAccountIDSet struct {
 	NodeAccountID AccountID
@@ -125,6 +127,21 @@ func (e ErrHederaReceiptStatus) Error() string {
 	return fmt.Sprintf("exceptional receipt status: %s", e.Status.String())
 }
 
+// ErrScheduleStatus is returned by ScheduleFlow.Execute if the scheduled transaction did not execute successfully.
+// Status is the receipt status of the scheduled transaction, SCHEDULE_ALREADY_DELETED if the schedule
+// was deleted or INVALID_SCHEDULE_ID if the schedule expired without executing.
+type ErrScheduleStatus struct {
+	ScheduleID ScheduleID
+	TxID       TransactionID
+	Status     Status
+	Receipt    *TransactionReceipt
+}
+
+// Error() implements the Error interface
+func (e ErrScheduleStatus) Error() string {
+	return fmt.Sprintf("scheduled transaction of schedule %s did not execute: %s", e.ScheduleID.String(), e.Status.String())
+}
+
 // ErrHederaRecordStatus is returned by TransactionID.GetRecord if the status of the record is exceptional.
 type ErrHederaRecordStatus struct {
 	TxID   TransactionID
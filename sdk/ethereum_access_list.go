@@ -0,0 +1,33 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// AccessTuple is an entry of the access list of an EIP-2930 or EIP-1559 transaction: a contract address and the
+// storage slots of it which the transaction accesses.
+type AccessTuple struct {
+	Address     Address
+	StorageKeys []Hash
+}
+
+// AccessList is the list of addresses and storage keys an Ethereum transaction plans to access, see EIP-2930
+type AccessList []AccessTuple
+
+// String returns a string representation of the AccessList
+func (accessList AccessList) String() string {
+	entries := make([]string, 0, len(accessList))
+	for _, tuple := range accessList {
+		keys := make([]string, 0, len(tuple.StorageKeys))
+		for _, key := range tuple.StorageKeys {
+			keys = append(keys, hex.EncodeToString(key[:]))
+		}
+		entries = append(entries, fmt.Sprintf("{%s: [%s]}", tuple.Address.String(), strings.Join(keys, ", ")))
+	}
+
+	return "[" + strings.Join(entries, ", ") + "]"
+}
@@ -7,8 +7,10 @@ package hiero
 
 import (
 	"encoding/hex"
+	"math/big"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -22,3 +24,178 @@ func TestUnitEthereumData(t *testing.T) {
 	k, err := b.ToBytes()
 	require.Equal(t, hex.EncodeToString(k), "02f87082012a022f2f83018000947e3a9eaf9bcc39e2ffa38eb30bf7a93feacbc181880de0b6b3a764000083123456c001a0df48f2efd10421811de2bfb125ab75b2d3c44139c4642837fb1fccce911fd479a01aaf7ae92bee896651dfc9d99ae422a296bf5d9f1ca49b2d96d82b79eb112d66")
 }
+
+func TestUnitEthereumLegacyTransactionSign(t *testing.T) {
+	t.Parallel()
+
+	// The example of EIP-155
+	key, err := PrivateKeyFromStringECDSA("4646464646464646464646464646464646464646464646464646464646464646")
+	require.NoError(t, err)
+	to, err := hex.DecodeString("3535353535353535353535353535353535353535")
+	require.NoError(t, err)
+
+	txn := NewUnsignedEthereumLegacyTransaction(1, 9, big.NewInt(20000000000), 21000, to, big.NewInt(1000000000000000000), nil)
+	assert.Equal(t, big.NewInt(1), txn.ChainID())
+
+	hash, err := txn.SigningHash()
+	require.NoError(t, err)
+	assert.Equal(t, "daf5a779ae972f972197303d7b574746c7ef83eadac0f2791ad23db92e4c8e53", hex.EncodeToString(hash[:]))
+
+	_, err = txn.RecoverSender()
+	require.Error(t, err)
+
+	_, err = txn.Sign(key)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(1), txn.ChainID())
+
+	bytes, err := txn.ToBytes()
+	require.NoError(t, err)
+	assert.Equal(t, "f86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83", hex.EncodeToString(bytes))
+
+	data, err := EthereumTransactionDataFromBytes(bytes)
+	require.NoError(t, err)
+	sender, err := data.RecoverSender()
+	require.NoError(t, err)
+	assert.Equal(t, "0x9d8A62f656a8d1615C1294fd71e9CFb3E4855A4F", sender.String())
+	assert.Equal(t, key.PublicKey().ToEvmAddress(), hex.EncodeToString(sender[:]))
+
+	txHash, err := data.Hash()
+	require.NoError(t, err)
+	assert.Equal(t, Keccak256Hash(bytes), txHash)
+
+	// Without a chain ID the signature isn't replay protected
+	txn = NewUnsignedEthereumLegacyTransaction(0, 9, big.NewInt(20000000000), 21000, to, big.NewInt(1), nil)
+	_, err = txn.Sign(key)
+	require.NoError(t, err)
+	assert.Contains(t, []string{"1b", "1c"}, hex.EncodeToString(txn.V))
+	assert.Equal(t, 0, txn.ChainID().Sign())
+	sender, err = txn.RecoverSender()
+	require.NoError(t, err)
+	assert.Equal(t, "0x9d8A62f656a8d1615C1294fd71e9CFb3E4855A4F", sender.String())
+}
+
+func TestUnitEthereumEIP1559TransactionSign(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+	to, err := hex.DecodeString("7e3a9eaf9bcc39e2ffa38eb30bf7a93feacbc181")
+	require.NoError(t, err)
+
+	data := NewEthereumTransactionDataEIP1559(NewUnsignedEthereumEIP1559Transaction(
+		298, 2, big.NewInt(47), big.NewInt(47), 98304, to, big.NewInt(1000000000000000000), []byte{0x12, 0x34, 0x56}))
+	_, err = data.Sign(key)
+	require.NoError(t, err)
+
+	bytes, err := data.ToBytes()
+	require.NoError(t, err)
+
+	decoded, err := EthereumTransactionDataFromBytes(bytes)
+	require.NoError(t, err)
+	sender, err := decoded.RecoverSender()
+	require.NoError(t, err)
+	assert.Equal(t, key.PublicKey().ToEvmAddress(), hex.EncodeToString(sender[:]))
+
+	// The signature covers the call data
+	decoded._SetData([]byte{0x12, 0x34, 0x57})
+	sender, err = decoded.RecoverSender()
+	if err == nil {
+		assert.NotEqual(t, key.PublicKey().ToEvmAddress(), hex.EncodeToString(sender[:]))
+	}
+
+	// A transaction signed elsewhere
+	byt, err := hex.DecodeString("02f87082012a022f2f83018000947e3a9eaf9bcc39e2ffa38eb30bf7a93feacbc181880de0b6b3a764000083123456c001a0df48f2efd10421811de2bfb125ab75b2d3c44139c4642837fb1fccce911fd479a01aaf7ae92bee896651dfc9d99ae422a296bf5d9f1ca49b2d96d82b79eb112d66")
+	require.NoError(t, err)
+	decoded, err = EthereumTransactionDataFromBytes(byt)
+	require.NoError(t, err)
+	_, err = decoded.RecoverSender()
+	require.NoError(t, err)
+	hash, err := decoded.Hash()
+	require.NoError(t, err)
+	assert.Equal(t, Keccak256Hash(byt), hash)
+
+	ed25519Key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	_, err = data.Sign(ed25519Key)
+	require.Error(t, err)
+
+	_, err = (&EthereumTransactionData{}).RecoverSender()
+	require.Error(t, err)
+}
+
+func TestUnitEthereumAccessList(t *testing.T) {
+	t.Parallel()
+
+	accessList := AccessList{{
+		Address:     BytesToAddress([]byte{1}),
+		StorageKeys: []Hash{{31: 1}},
+	}, {
+		Address: BytesToAddress([]byte{2}),
+	}}
+
+	bytes, err := RLPEncode(accessList)
+	require.NoError(t, err)
+	assert.Equal(t, "f84f"+
+		"f7"+"940000000000000000000000000000000000000001"+"e1a00000000000000000000000000000000000000000000000000000000000000001"+
+		"d6"+"940000000000000000000000000000000000000002"+"c0", hex.EncodeToString(bytes))
+
+	var decoded AccessList
+	require.NoError(t, RLPDecode(bytes, &decoded))
+	assert.Equal(t, accessList[0], decoded[0])
+	assert.Equal(t, accessList[1].Address, decoded[1].Address)
+	assert.Empty(t, decoded[1].StorageKeys)
+
+	assert.Equal(t, "[{0x0000000000000000000000000000000000000001: [0000000000000000000000000000000000000000000000000000000000000001]}, "+
+		"{0x0000000000000000000000000000000000000002: []}]", accessList.String())
+
+	require.Error(t, RLPDecode([]byte{0xc2, 0xc1, 0x01}, &decoded))
+}
+
+func TestUnitEthereumEIP2930Transaction(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+	to, err := hex.DecodeString("7e3a9eaf9bcc39e2ffa38eb30bf7a93feacbc181")
+	require.NoError(t, err)
+	accessList := AccessList{{Address: BytesToAddress(to), StorageKeys: []Hash{{1}, {2}}}}
+
+	txn, err := NewUnsignedEthereumEIP2930Transaction(
+		298, 2, big.NewInt(47), 98304, to, big.NewInt(1), []byte{0x12, 0x34}, accessList).Sign(key)
+	require.NoError(t, err)
+
+	bytes, err := txn.ToBytes()
+	require.NoError(t, err)
+	assert.Equal(t, byte(0x01), bytes[0])
+
+	data, err := EthereumTransactionDataFromBytes(bytes)
+	require.NoError(t, err)
+	roundTrip, err := data.ToBytes()
+	require.NoError(t, err)
+	assert.Equal(t, bytes, roundTrip)
+	assert.Equal(t, accessList, data.eip2930.AccessList)
+	assert.Equal(t, []byte{0x12, 0x34}, data._GetData())
+	assert.Contains(t, data.eip2930.String(), "AccessList: [{"+BytesToAddress(to).String()+": [")
+
+	sender, err := data.RecoverSender()
+	require.NoError(t, err)
+	assert.Equal(t, key.PublicKey().ToEvmAddress(), hex.EncodeToString(sender[:]))
+
+	hash, err := data.Hash()
+	require.NoError(t, err)
+	assert.Equal(t, Keccak256Hash(bytes), hash)
+
+	_, err = EthereumEIP2930TransactionFromBytes(bytes[1:])
+	require.Error(t, err)
+	_, err = EthereumTransactionDataFromBytes(nil)
+	require.Error(t, err)
+
+	// The access list of EIP-1559 transactions round trips too
+	eip1559 := NewUnsignedEthereumEIP1559Transaction(298, 2, big.NewInt(47), big.NewInt(47), 98304, to, nil, nil)
+	eip1559.AccessList = accessList
+	bytes, err = eip1559.ToBytes()
+	require.NoError(t, err)
+	decoded, err := EthereumEIP1559TransactionFromBytes(bytes)
+	require.NoError(t, err)
+	assert.Equal(t, accessList, decoded.AccessList)
+}
@@ -5,31 +5,44 @@ package hiero
 import (
 	"encoding/hex"
 	"fmt"
-	"strings"
+	"math/big"
 
 	"github.com/pkg/errors"
 )
 
 // EthereumEIP1559Transaction represents the EIP-1559 Ethereum transaction data.
 type EthereumEIP1559Transaction struct {
-	ChainId        []byte
-	Nonce          []byte
-	MaxPriorityGas []byte
-	MaxGas         []byte
-	GasLimit       []byte
+	ChainId        []byte `rlp:"uint"`
+	Nonce          []byte `rlp:"uint"`
+	MaxPriorityGas []byte `rlp:"uint"`
+	MaxGas         []byte `rlp:"uint"`
+	GasLimit       []byte `rlp:"uint"`
 	To             []byte
-	Value          []byte
+	Value          []byte `rlp:"uint"`
 	CallData       []byte
-	AccessList     [][]byte
-	RecoveryId     []byte
-	R              []byte
-	S              []byte
+	AccessList     AccessList
+	RecoveryId     []byte `rlp:"uint"`
+	R              []byte `rlp:"uint"`
+	S              []byte `rlp:"uint"`
+}
+
+// _EthereumEIP1559TransactionSigningPayload is the list signed by the sender, the transaction without its signature
+type _EthereumEIP1559TransactionSigningPayload struct {
+	ChainId        []byte `rlp:"uint"`
+	Nonce          []byte `rlp:"uint"`
+	MaxPriorityGas []byte `rlp:"uint"`
+	MaxGas         []byte `rlp:"uint"`
+	GasLimit       []byte `rlp:"uint"`
+	To             []byte
+	Value          []byte `rlp:"uint"`
+	CallData       []byte
+	AccessList     AccessList
 }
 
 // nolint
 // NewEthereumEIP1559Transaction creates a new EthereumEIP1559Transaction with the provided fields.
 func NewEthereumEIP1559Transaction(
-	chainId, nonce, maxPriorityGas, maxGas, gasLimit, to, value, callData, recoveryId, r, s []byte, accessList [][]byte) *EthereumEIP1559Transaction {
+	chainId, nonce, maxPriorityGas, maxGas, gasLimit, to, value, callData, recoveryId, r, s []byte, accessList AccessList) *EthereumEIP1559Transaction {
 	return &EthereumEIP1559Transaction{
 		ChainId:        chainId,
 		Nonce:          nonce,
@@ -46,85 +59,107 @@ func NewEthereumEIP1559Transaction(
 	}
 }
 
-// FromBytes decodes the RLP encoded bytes into an EthereumEIP1559Transaction.
+// NewUnsignedEthereumEIP1559Transaction creates an EIP-1559 Ethereum transaction to be signed with Sign. The gas
+// prices are in weibars, and a nil to deploys the call data as a contract.
+func NewUnsignedEthereumEIP1559Transaction(
+	chainID, nonce uint64, maxPriorityGas, maxGas *big.Int, gasLimit uint64, to []byte, value *big.Int, callData []byte) *EthereumEIP1559Transaction {
+	return NewEthereumEIP1559Transaction(
+		encodeBinary(chainID),
+		encodeBinary(nonce),
+		_EthereumBigIntBytes(maxPriorityGas),
+		_EthereumBigIntBytes(maxGas),
+		encodeBinary(gasLimit),
+		to,
+		_EthereumBigIntBytes(value),
+		callData,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+}
+
+// EthereumEIP1559TransactionFromBytes decodes the RLP encoded bytes into an EthereumEIP1559Transaction.
 func EthereumEIP1559TransactionFromBytes(bytes []byte) (*EthereumEIP1559Transaction, error) {
 	if len(bytes) == 0 || bytes[0] != 0x02 {
 		return nil, errors.New("input byte array is malformed; it should start with 0x02 followed by 12 RLP-encoded elements")
 	}
 
 	// Remove the prefix byte (0x02)
-	item := NewRLPItem(LIST_TYPE)
-	if err := item.Read(bytes[1:]); err != nil {
+	var txn EthereumEIP1559Transaction
+	if err := RLPDecode(bytes[1:], &txn); err != nil {
 		return nil, errors.Wrap(err, "failed to read RLP data")
 	}
 
-	if item.itemType != LIST_TYPE || len(item.childItems) != 12 {
-		return nil, errors.New("input byte array is malformed; it should be a list of 12 RLP-encoded elements")
-	}
+	return &txn, nil
+}
 
-	// Handle the access list
-	var accessListValues [][]byte
-	for _, child := range item.childItems[8].childItems {
-		accessListValues = append(accessListValues, child.itemValue)
+// ToBytes encodes the EthereumEIP1559Transaction into RLP format.
+func (txn *EthereumEIP1559Transaction) ToBytes() ([]byte, error) {
+	transactionBytes, err := RLPEncode(txn)
+	if err != nil {
+		return nil, err
 	}
 
-	// Extract values from the RLP item
-	return NewEthereumEIP1559Transaction(
-		item.childItems[0].itemValue,
-		item.childItems[1].itemValue,
-		item.childItems[2].itemValue,
-		item.childItems[3].itemValue,
-		item.childItems[4].itemValue,
-		item.childItems[5].itemValue,
-		item.childItems[6].itemValue,
-		item.childItems[7].itemValue,
-		item.childItems[9].itemValue,
-		item.childItems[10].itemValue,
-		item.childItems[11].itemValue,
-		accessListValues,
-	), nil
+	return append([]byte{0x02}, transactionBytes...), nil
 }
 
-// ToBytes encodes the EthereumEIP1559Transaction into RLP format.
-func (txn *EthereumEIP1559Transaction) ToBytes() ([]byte, error) {
-	item := NewRLPItem(LIST_TYPE)
-	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.ChainId))
-	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.Nonce))
-	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.MaxPriorityGas))
-	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.MaxGas))
-	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.GasLimit))
-	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.To))
-	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.Value))
-	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.CallData))
-	accessListItem := NewRLPItem(LIST_TYPE)
-	for _, itemBytes := range txn.AccessList {
-		accessListItem.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(itemBytes))
+// SigningHash returns the hash signed by the sender, which covers every field but the signature
+func (txn *EthereumEIP1559Transaction) SigningHash() (Hash, error) {
+	bytes, err := RLPEncode(_EthereumEIP1559TransactionSigningPayload{
+		ChainId:        txn.ChainId,
+		Nonce:          txn.Nonce,
+		MaxPriorityGas: txn.MaxPriorityGas,
+		MaxGas:         txn.MaxGas,
+		GasLimit:       txn.GasLimit,
+		To:             txn.To,
+		Value:          txn.Value,
+		CallData:       txn.CallData,
+		AccessList:     txn.AccessList,
+	})
+	if err != nil {
+		return Hash{}, err
 	}
-	item.PushBack(accessListItem)
-	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.RecoveryId))
-	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.R))
-	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.S))
 
-	transactionBytes, err := item.Write()
+	return Keccak256Hash(append([]byte{0x02}, bytes...)), nil
+}
+
+// Sign signs the transaction with a secp256k1 private key and sets RecoveryId, R and S
+func (txn *EthereumEIP1559Transaction) Sign(key PrivateKey) (*EthereumEIP1559Transaction, error) {
+	hash, err := txn.SigningHash()
 	if err != nil {
 		return nil, err
 	}
-	// Append 02 byte as it is the standard for EIP1559
-	combinedBytes := append([]byte{0x02}, transactionBytes...)
 
-	return combinedBytes, nil
+	if txn.RecoveryId, txn.R, txn.S, err = _SignTypedEthereumTransaction(key, hash); err != nil {
+		return nil, err
+	}
+
+	return txn, nil
 }
 
-// String returns a string representation of the EthereumEIP1559Transaction.
-func (txn *EthereumEIP1559Transaction) String() string {
-	// Encode each element in the AccessList slice individually
-	var encodedAccessList []string
-	for _, entry := range txn.AccessList {
-		encodedAccessList = append(encodedAccessList, hex.EncodeToString(entry))
+// Hash returns the hash of the signed transaction, which identifies it on Ethereum compatible networks
+func (txn *EthereumEIP1559Transaction) Hash() (Hash, error) {
+	bytes, err := txn.ToBytes()
+	if err != nil {
+		return Hash{}, err
 	}
 
-	accessListStr := "[" + strings.Join(encodedAccessList, ", ") + "]"
+	return Keccak256Hash(bytes), nil
+}
+
+// RecoverSender returns the address of the account which signed the transaction
+func (txn *EthereumEIP1559Transaction) RecoverSender() (Address, error) {
+	hash, err := txn.SigningHash()
+	if err != nil {
+		return Address{}, err
+	}
 
+	return _RecoverTypedEthereumTransactionSender(hash, txn.RecoveryId, txn.R, txn.S)
+}
+
+// String returns a string representation of the EthereumEIP1559Transaction.
+func (txn *EthereumEIP1559Transaction) String() string {
 	return fmt.Sprintf("ChainId: %s\nNonce: %s\nMaxPriorityGas: %s\nMaxGas: %s\nGasLimit: %s\nTo: %s\nValue: %s\nCallData: %s\nAccessList: %s\nRecoveryId: %s\nR: %s\nS: %s",
 		hex.EncodeToString(txn.ChainId),
 		hex.EncodeToString(txn.Nonce),
@@ -134,7 +169,7 @@ func (txn *EthereumEIP1559Transaction) String() string {
 		hex.EncodeToString(txn.To),
 		hex.EncodeToString(txn.Value),
 		hex.EncodeToString(txn.CallData),
-		accessListStr,
+		txn.AccessList.String(),
 		hex.EncodeToString(txn.RecoveryId),
 		hex.EncodeToString(txn.R),
 		hex.EncodeToString(txn.S),
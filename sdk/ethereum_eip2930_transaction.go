@@ -0,0 +1,172 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+// EthereumEIP2930Transaction represents the EIP-2930 Ethereum transaction data, a legacy priced transaction
+// with an access list.
+type EthereumEIP2930Transaction struct {
+	ChainId    []byte `rlp:"uint"`
+	Nonce      []byte `rlp:"uint"`
+	GasPrice   []byte `rlp:"uint"`
+	GasLimit   []byte `rlp:"uint"`
+	To         []byte
+	Value      []byte `rlp:"uint"`
+	CallData   []byte
+	AccessList AccessList
+	RecoveryId []byte `rlp:"uint"`
+	R          []byte `rlp:"uint"`
+	S          []byte `rlp:"uint"`
+}
+
+// _EthereumEIP2930TransactionSigningPayload is the list signed by the sender, the transaction without its signature
+type _EthereumEIP2930TransactionSigningPayload struct {
+	ChainId    []byte `rlp:"uint"`
+	Nonce      []byte `rlp:"uint"`
+	GasPrice   []byte `rlp:"uint"`
+	GasLimit   []byte `rlp:"uint"`
+	To         []byte
+	Value      []byte `rlp:"uint"`
+	CallData   []byte
+	AccessList AccessList
+}
+
+// nolint
+// NewEthereumEIP2930Transaction creates a new EthereumEIP2930Transaction with the provided fields.
+func NewEthereumEIP2930Transaction(
+	chainId, nonce, gasPrice, gasLimit, to, value, callData, recoveryId, r, s []byte, accessList AccessList) *EthereumEIP2930Transaction {
+	return &EthereumEIP2930Transaction{
+		ChainId:    chainId,
+		Nonce:      nonce,
+		GasPrice:   gasPrice,
+		GasLimit:   gasLimit,
+		To:         to,
+		Value:      value,
+		CallData:   callData,
+		AccessList: accessList,
+		RecoveryId: recoveryId,
+		R:          r,
+		S:          s,
+	}
+}
+
+// NewUnsignedEthereumEIP2930Transaction creates an EIP-2930 Ethereum transaction to be signed with Sign. The gas
+// price is in weibars, and a nil to deploys the call data as a contract.
+func NewUnsignedEthereumEIP2930Transaction(
+	chainID, nonce uint64, gasPrice *big.Int, gasLimit uint64, to []byte, value *big.Int, callData []byte, accessList AccessList) *EthereumEIP2930Transaction {
+	return NewEthereumEIP2930Transaction(
+		encodeBinary(chainID),
+		encodeBinary(nonce),
+		_EthereumBigIntBytes(gasPrice),
+		encodeBinary(gasLimit),
+		to,
+		_EthereumBigIntBytes(value),
+		callData,
+		nil,
+		nil,
+		nil,
+		accessList,
+	)
+}
+
+// EthereumEIP2930TransactionFromBytes decodes the RLP encoded bytes into an EthereumEIP2930Transaction.
+func EthereumEIP2930TransactionFromBytes(bytes []byte) (*EthereumEIP2930Transaction, error) {
+	if len(bytes) == 0 || bytes[0] != 0x01 {
+		return nil, errors.New("input byte array is malformed; it should start with 0x01 followed by 11 RLP-encoded elements")
+	}
+
+	// Remove the prefix byte (0x01)
+	var txn EthereumEIP2930Transaction
+	if err := RLPDecode(bytes[1:], &txn); err != nil {
+		return nil, errors.Wrap(err, "failed to read RLP data")
+	}
+
+	return &txn, nil
+}
+
+// ToBytes encodes the EthereumEIP2930Transaction into RLP format.
+func (txn *EthereumEIP2930Transaction) ToBytes() ([]byte, error) {
+	transactionBytes, err := RLPEncode(txn)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{0x01}, transactionBytes...), nil
+}
+
+// SigningHash returns the hash signed by the sender, which covers every field but the signature
+func (txn *EthereumEIP2930Transaction) SigningHash() (Hash, error) {
+	bytes, err := RLPEncode(_EthereumEIP2930TransactionSigningPayload{
+		ChainId:    txn.ChainId,
+		Nonce:      txn.Nonce,
+		GasPrice:   txn.GasPrice,
+		GasLimit:   txn.GasLimit,
+		To:         txn.To,
+		Value:      txn.Value,
+		CallData:   txn.CallData,
+		AccessList: txn.AccessList,
+	})
+	if err != nil {
+		return Hash{}, err
+	}
+
+	return Keccak256Hash(append([]byte{0x01}, bytes...)), nil
+}
+
+// Sign signs the transaction with a secp256k1 private key and sets RecoveryId, R and S
+func (txn *EthereumEIP2930Transaction) Sign(key PrivateKey) (*EthereumEIP2930Transaction, error) {
+	hash, err := txn.SigningHash()
+	if err != nil {
+		return nil, err
+	}
+
+	if txn.RecoveryId, txn.R, txn.S, err = _SignTypedEthereumTransaction(key, hash); err != nil {
+		return nil, err
+	}
+
+	return txn, nil
+}
+
+// Hash returns the hash of the signed transaction, which identifies it on Ethereum compatible networks
+func (txn *EthereumEIP2930Transaction) Hash() (Hash, error) {
+	bytes, err := txn.ToBytes()
+	if err != nil {
+		return Hash{}, err
+	}
+
+	return Keccak256Hash(bytes), nil
+}
+
+// RecoverSender returns the address of the account which signed the transaction
+func (txn *EthereumEIP2930Transaction) RecoverSender() (Address, error) {
+	hash, err := txn.SigningHash()
+	if err != nil {
+		return Address{}, err
+	}
+
+	return _RecoverTypedEthereumTransactionSender(hash, txn.RecoveryId, txn.R, txn.S)
+}
+
+// String returns a string representation of the EthereumEIP2930Transaction.
+func (txn *EthereumEIP2930Transaction) String() string {
+	return fmt.Sprintf("ChainId: %s\nNonce: %s\nGasPrice: %s\nGasLimit: %s\nTo: %s\nValue: %s\nCallData: %s\nAccessList: %s\nRecoveryId: %s\nR: %s\nS: %s",
+		hex.EncodeToString(txn.ChainId),
+		hex.EncodeToString(txn.Nonce),
+		hex.EncodeToString(txn.GasPrice),
+		hex.EncodeToString(txn.GasLimit),
+		hex.EncodeToString(txn.To),
+		hex.EncodeToString(txn.Value),
+		hex.EncodeToString(txn.CallData),
+		txn.AccessList.String(),
+		hex.EncodeToString(txn.RecoveryId),
+		hex.EncodeToString(txn.R),
+		hex.EncodeToString(txn.S),
+	)
+}
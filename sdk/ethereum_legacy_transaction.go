@@ -5,21 +5,34 @@ package hiero
 import (
 	"encoding/hex"
 	"fmt"
+	"math/big"
 
 	"github.com/pkg/errors"
 )
 
 // EthereumLegacyTransaction represents the legacy Ethereum transaction data.
 type EthereumLegacyTransaction struct {
-	Nonce    []byte
-	GasPrice []byte
-	GasLimit []byte
+	Nonce    []byte `rlp:"uint"`
+	GasPrice []byte `rlp:"uint"`
+	GasLimit []byte `rlp:"uint"`
 	To       []byte
-	Value    []byte
+	Value    []byte `rlp:"uint"`
 	CallData []byte
-	V        []byte
-	R        []byte
-	S        []byte
+	V        []byte `rlp:"uint"`
+	R        []byte `rlp:"uint"`
+	S        []byte `rlp:"uint"`
+}
+
+// _EthereumLegacySigningPayload is the list signed by the sender of a legacy transaction, which ends with the
+// chain ID, 0 and 0 as of EIP-155
+type _EthereumLegacySigningPayload struct {
+	Nonce    []byte `rlp:"uint"`
+	GasPrice []byte `rlp:"uint"`
+	GasLimit []byte `rlp:"uint"`
+	To       []byte
+	Value    []byte `rlp:"uint"`
+	CallData []byte
+	EIP155   []*big.Int `rlp:"tail"`
 }
 
 // nolint
@@ -38,49 +51,37 @@ func NewEthereumLegacyTransaction(nonce, gasPrice, gasLimit, to, value, callData
 	}
 }
 
+// NewUnsignedEthereumLegacyTransaction creates a legacy Ethereum transaction to be signed with Sign. A non zero chain
+// ID makes the signature replay protected as of EIP-155, and a nil to deploys the call data as a contract.
+// Until the transaction is signed, V holds the chain ID.
+func NewUnsignedEthereumLegacyTransaction(
+	chainID, nonce uint64, gasPrice *big.Int, gasLimit uint64, to []byte, value *big.Int, callData []byte) *EthereumLegacyTransaction {
+	return NewEthereumLegacyTransaction(
+		encodeBinary(nonce),
+		_EthereumBigIntBytes(gasPrice),
+		encodeBinary(gasLimit),
+		to,
+		_EthereumBigIntBytes(value),
+		callData,
+		encodeBinary(chainID),
+		nil,
+		nil,
+	)
+}
+
 // FromBytes decodes the RLP encoded bytes into an EthereumLegacyTransaction.
 func EthereumLegacyTransactionFromBytes(bytes []byte) (*EthereumLegacyTransaction, error) {
-	item := NewRLPItem(LIST_TYPE)
-	if err := item.Read(bytes); err != nil {
+	var txn EthereumLegacyTransaction
+	if err := RLPDecode(bytes, &txn); err != nil {
 		return nil, errors.Wrap(err, "failed to read RLP data")
 	}
 
-	if item.itemType != LIST_TYPE {
-		return nil, errors.New("input byte array does not represent a list of RLP-encoded elements")
-	}
-
-	if len(item.childItems) != 9 {
-		return nil, errors.New("input byte array does not contain 9 RLP-encoded elements")
-	}
-
-	// Extract the values from the RLP item
-	return NewEthereumLegacyTransaction(
-		item.childItems[0].itemValue,
-		item.childItems[1].itemValue,
-		item.childItems[2].itemValue,
-		item.childItems[3].itemValue,
-		item.childItems[4].itemValue,
-		item.childItems[5].itemValue,
-		item.childItems[6].itemValue,
-		item.childItems[7].itemValue,
-		item.childItems[8].itemValue,
-	), nil
+	return &txn, nil
 }
 
 // ToBytes encodes the EthereumLegacyTransaction into RLP format.
 func (txn *EthereumLegacyTransaction) ToBytes() ([]byte, error) {
-	item := NewRLPItem(LIST_TYPE)
-	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.Nonce))
-	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.GasPrice))
-	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.GasLimit))
-	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.To))
-	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.Value))
-	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.CallData))
-	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.V))
-	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.R))
-	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.S))
-
-	return item.Write()
+	return RLPEncode(txn)
 }
 
 // String returns a string representation of the EthereumLegacyTransaction.
@@ -97,3 +98,104 @@ func (txn *EthereumLegacyTransaction) String() string {
 		hex.EncodeToString(txn.S),
 	)
 }
+
+// ChainID returns the chain ID the transaction is signed for, which is 0 when it isn't replay protected
+func (txn *EthereumLegacyTransaction) ChainID() *big.Int {
+	v := new(big.Int).SetBytes(txn.V)
+	if !txn._IsSigned() {
+		return v
+	}
+	if v.Cmp(big.NewInt(35)) < 0 {
+		return new(big.Int)
+	}
+
+	return v.Sub(v, big.NewInt(35)).Rsh(v, 1)
+}
+
+// SigningHash returns the hash signed by the sender, as of EIP-155 when the transaction has a chain ID
+func (txn *EthereumLegacyTransaction) SigningHash() (Hash, error) {
+	payload := _EthereumLegacySigningPayload{
+		Nonce:    txn.Nonce,
+		GasPrice: txn.GasPrice,
+		GasLimit: txn.GasLimit,
+		To:       txn.To,
+		Value:    txn.Value,
+		CallData: txn.CallData,
+	}
+	if chainID := txn.ChainID(); chainID.Sign() > 0 {
+		payload.EIP155 = []*big.Int{chainID, new(big.Int), new(big.Int)}
+	}
+
+	bytes, err := RLPEncode(payload)
+	if err != nil {
+		return Hash{}, err
+	}
+
+	return Keccak256Hash(bytes), nil
+}
+
+// Sign signs the transaction with a secp256k1 private key and sets V, R and S
+func (txn *EthereumLegacyTransaction) Sign(key PrivateKey) (*EthereumLegacyTransaction, error) {
+	if key.ecdsaPrivateKey == nil {
+		return nil, _NewErrBadKeyf("ethereum transactions can only be signed with an ECDSA secp256k1 key")
+	}
+
+	chainID := txn.ChainID()
+	hash, err := txn.SigningHash()
+	if err != nil {
+		return nil, err
+	}
+
+	r, s, recoveryID := key.ecdsaPrivateKey._SignRecoverable(hash[:])
+
+	v := big.NewInt(27 + int64(recoveryID))
+	if chainID.Sign() > 0 {
+		v = new(big.Int).Lsh(chainID, 1)
+		v.Add(v, big.NewInt(35+int64(recoveryID)))
+	}
+
+	txn.V = v.Bytes()
+	txn.R = new(big.Int).SetBytes(r).Bytes()
+	txn.S = new(big.Int).SetBytes(s).Bytes()
+
+	return txn, nil
+}
+
+// Hash returns the hash of the signed transaction, which identifies it on Ethereum compatible networks
+func (txn *EthereumLegacyTransaction) Hash() (Hash, error) {
+	bytes, err := txn.ToBytes()
+	if err != nil {
+		return Hash{}, err
+	}
+
+	return Keccak256Hash(bytes), nil
+}
+
+// RecoverSender returns the address of the account which signed the transaction
+func (txn *EthereumLegacyTransaction) RecoverSender() (Address, error) {
+	if !txn._IsSigned() {
+		return Address{}, errors.New("transaction is not signed")
+	}
+
+	recoveryID := new(big.Int).SetBytes(txn.V)
+	if chainID := txn.ChainID(); chainID.Sign() > 0 {
+		recoveryID.Sub(recoveryID, new(big.Int).Lsh(chainID, 1))
+		recoveryID.Sub(recoveryID, big.NewInt(35))
+	} else {
+		recoveryID.Sub(recoveryID, big.NewInt(27))
+	}
+	if recoveryID.Sign() < 0 || recoveryID.Cmp(big.NewInt(1)) > 0 {
+		return Address{}, fmt.Errorf("invalid signature v %s", hex.EncodeToString(txn.V))
+	}
+
+	hash, err := txn.SigningHash()
+	if err != nil {
+		return Address{}, err
+	}
+
+	return _RecoverEthereumAddress(hash[:], leftPad(txn.R, 32), leftPad(txn.S, 32), byte(recoveryID.Uint64()))
+}
+
+func (txn *EthereumLegacyTransaction) _IsSigned() bool {
+	return len(txn.R) > 0 || len(txn.S) > 0
+}
@@ -2,15 +2,53 @@ package hiero
 
 // SPDX-License-Identifier: Apache-2.0
 
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
 // Represents the data of an Ethereum transaction.
 type EthereumTransactionData struct {
 	eip1559 *EthereumEIP1559Transaction
+	eip2930 *EthereumEIP2930Transaction
 	legacy  *EthereumLegacyTransaction
 }
 
+// NewEthereumTransactionDataLegacy wraps a legacy transaction, eg. to execute it with EthereumFlow.SetEthereumData
+func NewEthereumTransactionDataLegacy(legacy *EthereumLegacyTransaction) *EthereumTransactionData {
+	return &EthereumTransactionData{legacy: legacy}
+}
+
+// NewEthereumTransactionDataEIP1559 wraps an EIP-1559 transaction, eg. to execute it with EthereumFlow.SetEthereumData
+func NewEthereumTransactionDataEIP1559(eip1559 *EthereumEIP1559Transaction) *EthereumTransactionData {
+	return &EthereumTransactionData{eip1559: eip1559}
+}
+
+// NewEthereumTransactionDataEIP2930 wraps an EIP-2930 transaction, eg. to execute it with EthereumFlow.SetEthereumData
+func NewEthereumTransactionDataEIP2930(eip2930 *EthereumEIP2930Transaction) *EthereumTransactionData {
+	return &EthereumTransactionData{eip2930: eip2930}
+}
+
 // EthereumTransactionDataFromBytes constructs an EthereumTransactionData from a raw byte array.
 func EthereumTransactionDataFromBytes(b []byte) (*EthereumTransactionData, error) {
 	var transactionData EthereumTransactionData
+	if len(b) == 0 {
+		return nil, errEthereumTransactionDataEmpty
+	}
+
+	if b[0] == 0x01 {
+		eip2930, err := EthereumEIP2930TransactionFromBytes(b)
+		if err != nil {
+			return nil, err
+		}
+
+		transactionData.eip2930 = eip2930
+		return &transactionData, nil
+	}
+
 	if b[0] == 0x02 {
 		eip1559, err := EthereumEIP1559TransactionFromBytes(b)
 		if err != nil {
@@ -36,6 +74,10 @@ func (txData *EthereumTransactionData) ToBytes() ([]byte, error) {
 		return txData.eip1559.ToBytes()
 	}
 
+	if txData.eip2930 != nil {
+		return txData.eip2930.ToBytes()
+	}
+
 	if txData.legacy != nil {
 		return txData.legacy.ToBytes()
 	}
@@ -43,11 +85,103 @@ func (txData *EthereumTransactionData) ToBytes() ([]byte, error) {
 	return nil, nil
 }
 
+// Sign signs the wrapped transaction with a secp256k1 private key
+func (txData *EthereumTransactionData) Sign(key PrivateKey) (*EthereumTransactionData, error) {
+	var err error
+	switch {
+	case txData.eip1559 != nil:
+		_, err = txData.eip1559.Sign(key)
+	case txData.eip2930 != nil:
+		_, err = txData.eip2930.Sign(key)
+	case txData.legacy != nil:
+		_, err = txData.legacy.Sign(key)
+	default:
+		err = errEthereumTransactionDataEmpty
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return txData, nil
+}
+
+// Hash returns the Ethereum hash of the wrapped transaction
+func (txData *EthereumTransactionData) Hash() (Hash, error) {
+	if txData.eip1559 != nil {
+		return txData.eip1559.Hash()
+	}
+
+	if txData.eip2930 != nil {
+		return txData.eip2930.Hash()
+	}
+
+	if txData.legacy != nil {
+		return txData.legacy.Hash()
+	}
+
+	return Hash{}, errEthereumTransactionDataEmpty
+}
+
+// RecoverSender returns the address of the account which signed the wrapped transaction
+func (txData *EthereumTransactionData) RecoverSender() (Address, error) {
+	if txData.eip1559 != nil {
+		return txData.eip1559.RecoverSender()
+	}
+
+	if txData.eip2930 != nil {
+		return txData.eip2930.RecoverSender()
+	}
+
+	if txData.legacy != nil {
+		return txData.legacy.RecoverSender()
+	}
+
+	return Address{}, errEthereumTransactionDataEmpty
+}
+
+// _EthereumBigIntBytes returns the minimal big endian encoding of an integer field, which is empty for nil and 0
+func _EthereumBigIntBytes(value *big.Int) []byte {
+	if value == nil {
+		return nil
+	}
+
+	return value.Bytes()
+}
+
+// _SignTypedEthereumTransaction signs the signing hash of an EIP-2718 typed transaction, and returns its recovery
+// id, r and s fields
+func _SignTypedEthereumTransaction(key PrivateKey, hash Hash) ([]byte, []byte, []byte, error) {
+	if key.ecdsaPrivateKey == nil {
+		return nil, nil, nil, _NewErrBadKeyf("ethereum transactions can only be signed with an ECDSA secp256k1 key")
+	}
+
+	r, s, recoveryID := key.ecdsaPrivateKey._SignRecoverable(hash[:])
+	return encodeBinary(uint64(recoveryID)), new(big.Int).SetBytes(r).Bytes(), new(big.Int).SetBytes(s).Bytes(), nil
+}
+
+// _RecoverTypedEthereumTransactionSender recovers the signer of an EIP-2718 typed transaction from its signing hash
+func _RecoverTypedEthereumTransactionSender(hash Hash, recoveryID, r, s []byte) (Address, error) {
+	if len(r) == 0 && len(s) == 0 {
+		return Address{}, errors.New("transaction is not signed")
+	}
+
+	id := new(big.Int).SetBytes(recoveryID)
+	if id.Cmp(big.NewInt(1)) > 0 {
+		return Address{}, fmt.Errorf("invalid recovery id %s", hex.EncodeToString(recoveryID))
+	}
+
+	return _RecoverEthereumAddress(hash[:], leftPad(r, 32), leftPad(s, 32), byte(id.Uint64()))
+}
+
 func (ethereumTxData *EthereumTransactionData) _GetData() []byte {
 	if ethereumTxData.eip1559 != nil {
 		return ethereumTxData.eip1559.CallData
 	}
 
+	if ethereumTxData.eip2930 != nil {
+		return ethereumTxData.eip2930.CallData
+	}
+
 	return ethereumTxData.legacy.CallData
 }
 
@@ -57,6 +191,11 @@ func (ethereumTxData *EthereumTransactionData) _SetData(data []byte) *EthereumTr
 		return ethereumTxData
 	}
 
+	if ethereumTxData.eip2930 != nil {
+		ethereumTxData.eip2930.CallData = data
+		return ethereumTxData
+	}
+
 	ethereumTxData.legacy.CallData = data
 	return ethereumTxData
 }
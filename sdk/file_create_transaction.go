@@ -143,6 +143,10 @@ func (tx *FileCreateTransaction) GetMemo() string {
 func (tx FileCreateTransaction) getName() string {
 	return "FileCreateTransaction"
 }
+
+func (tx FileCreateTransaction) validateBody() []ErrTransactionValidation {
+	return _ValidateMemo("file memo", tx.memo)
+}
 func (tx FileCreateTransaction) build() *services.TransactionBody {
 	return &services.TransactionBody{
 		TransactionFee:           tx.transactionFee,
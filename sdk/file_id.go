@@ -159,6 +159,28 @@ func (id FileID) ToBytes() []byte {
 	return data
 }
 
+// MarshalJSON implements the encoding.JSON interface.
+func (id FileID) MarshalJSON() ([]byte, error) {
+	return _StringToJSON(id.String())
+}
+
+// UnmarshalJSON implements the encoding.JSON interface.
+func (id *FileID) UnmarshalJSON(data []byte) error {
+	value, err := _StringFromJSON(data)
+	if err != nil {
+		return err
+	}
+
+	result, err := FileIDFromString(value)
+	if err != nil {
+		return err
+	}
+
+	*id = result
+
+	return nil
+}
+
 // FileIDFromBytes returns a FileID from a byte array
 func FileIDFromBytes(data []byte) (FileID, error) {
 	if data == nil {
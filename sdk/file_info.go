@@ -74,6 +74,28 @@ func (fileInfo FileInfo) ToBytes() []byte {
 	return data
 }
 
+// MarshalJSON returns the protobuf JSON mapping of the proto.FileGetInfoResponse.FileInfo message for the FileInfo.
+func (fileInfo FileInfo) MarshalJSON() ([]byte, error) {
+	return _ProtobufToJSON(fileInfo._ToProtobuf())
+}
+
+// UnmarshalJSON decodes a FileInfo from the protobuf JSON mapping of the proto.FileGetInfoResponse.FileInfo message.
+func (fileInfo *FileInfo) UnmarshalJSON(data []byte) error {
+	pb := services.FileGetInfoResponse_FileInfo{}
+	if err := _ProtobufFromJSON(data, &pb); err != nil {
+		return err
+	}
+
+	result, err := _FileInfoFromProtobuf(&pb)
+	if err != nil {
+		return err
+	}
+
+	*fileInfo = result
+
+	return nil
+}
+
 // FileInfoFromBytes returns a FileInfo object from a raw byte array
 func FileInfoFromBytes(data []byte) (FileInfo, error) {
 	if data == nil {
@@ -153,6 +153,10 @@ func (tx *FileUpdateTransaction) GetFileMemo() string {
 func (tx FileUpdateTransaction) getName() string {
 	return "FileUpdateTransaction"
 }
+
+func (tx FileUpdateTransaction) validateBody() []ErrTransactionValidation {
+	return _ValidateMemo("file memo", tx.memo)
+}
 func (tx FileUpdateTransaction) validateNetworkOnIDs(client *Client) error {
 	if client == nil || !client.autoValidateChecksums {
 		return nil
@@ -0,0 +1,54 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// JSON encoding of the SDK types follows three rules:
+//
+//   - ID types (AccountID, ContractID, DelegatableContractID, FileID, NftID, ScheduleID, TokenID, TopicID and
+//     TransactionID) are encoded as a JSON string holding their String() form, eg. "0.0.1001" or
+//     "0.0.1001@1700000000.000000001". Decoding accepts everything the matching FromString function accepts.
+//   - Query result types (AccountBalance, AccountInfo, ContractFunctionResult, ContractInfo, FileInfo,
+//     NetworkVersionInfo, NodeAddressBook, ScheduleInfo, TokenInfo, TokenNftInfo and TopicInfo) are encoded with the
+//     protobuf JSON mapping of the HAPI message their ToBytes method serializes, using lowerCamelCase field names.
+//   - Transactions are encoded with the protobuf JSON mapping of the sdk.TransactionList produced by ToBytes,
+//     see Transaction.ToJSON and TransactionFromJSON.
+//
+// TransactionResponse, TransactionReceipt and TransactionRecord keep the format shared with the other SDKs.
+
+// _ProtobufToJSON encodes a protobuf message as compact JSON. protojson randomizes whitespace on purpose,
+// compacting the output makes the encoding stable.
+func _ProtobufToJSON(message protobuf.Message) ([]byte, error) {
+	data, err := protojson.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+
+	var buffer bytes.Buffer
+	if err := json.Compact(&buffer, data); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func _ProtobufFromJSON(data []byte, message protobuf.Message) error {
+	return protojson.Unmarshal(data, message)
+}
+
+func _StringToJSON(value string) ([]byte, error) {
+	return json.Marshal(value)
+}
+
+func _StringFromJSON(data []byte) (string, error) {
+	var value string
+	err := json.Unmarshal(data, &value)
+	return value, err
+}
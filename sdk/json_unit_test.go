@@ -0,0 +1,256 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitJSONIDs(t *testing.T) {
+	t.Parallel()
+
+	type ids struct {
+		Account       AccountID             `json:"account"`
+		Contract      ContractID            `json:"contract"`
+		Delegatable   DelegatableContractID `json:"delegatable"`
+		File          FileID                `json:"file"`
+		Nft           NftID                 `json:"nft"`
+		Schedule      ScheduleID            `json:"schedule"`
+		Token         TokenID               `json:"token"`
+		Topic         TopicID               `json:"topic"`
+		Transaction   TransactionID         `json:"transaction"`
+		OptionalTopic *TopicID              `json:"optionalTopic"`
+	}
+
+	original := ids{
+		Account:     AccountID{Account: 1},
+		Contract:    ContractID{Shard: 1, Realm: 2, Contract: 3},
+		Delegatable: DelegatableContractID{Contract: 4},
+		File:        FileID{File: 5},
+		Nft:         NftID{TokenID: TokenID{Token: 6}, SerialNumber: 7},
+		Schedule:    ScheduleID{Schedule: 8},
+		Token:       TokenID{Token: 9},
+		Topic:       TopicID{Topic: 10},
+		Transaction: testTransactionID,
+	}
+
+	data, err := json.Marshal(original)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"account": "0.0.1",
+		"contract": "1.2.3",
+		"delegatable": "0.0.4",
+		"file": "0.0.5",
+		"nft": "0.0.6@7",
+		"schedule": "0.0.8",
+		"token": "0.0.9",
+		"topic": "0.0.10",
+		"transaction": "`+testTransactionID.String()+`",
+		"optionalTopic": null
+	}`, string(data))
+
+	var decoded ids
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, original.Account.String(), decoded.Account.String())
+	assert.Equal(t, original.Nft.String(), decoded.Nft.String())
+	assert.Equal(t, original.Transaction.String(), decoded.Transaction.String())
+	assert.Nil(t, decoded.OptionalTopic)
+
+	var accountID AccountID
+	require.Error(t, json.Unmarshal([]byte(`"not an id"`), &accountID))
+}
+
+func TestUnitJSONQueryResults(t *testing.T) {
+	t.Parallel()
+
+	expiration := time.Unix(1700000000, 0)
+	autoRenewPeriod := 90 * 24 * time.Hour
+	tokenInfo := TokenInfo{
+		TokenID:         TokenID{Token: 3},
+		Name:            "name",
+		Symbol:          "SYM",
+		Decimals:        2,
+		TotalSupply:     1000,
+		Treasury:        AccountID{Account: 2},
+		ExpirationTime:  &expiration,
+		AutoRenewPeriod: &autoRenewPeriod,
+		TokenMemo:       "memo",
+	}
+
+	data, err := json.Marshal(tokenInfo)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"name":"name"`)
+	assert.Contains(t, string(data), `"totalSupply":"1000"`)
+
+	var decodedTokenInfo TokenInfo
+	require.NoError(t, json.Unmarshal(data, &decodedTokenInfo))
+	assert.Equal(t, tokenInfo.ToBytes(), decodedTokenInfo.ToBytes())
+
+	executedAt := time.Unix(1700000100, 0)
+	scheduleInfo := ScheduleInfo{
+		ScheduleID:       ScheduleID{Schedule: 4},
+		CreatorAccountID: AccountID{Account: 2},
+		PayerAccountID:   AccountID{Account: 2},
+		ExecutedAt:       &executedAt,
+		ExpirationTime:   expiration,
+		Memo:             "schedule",
+	}
+
+	data, err = json.Marshal(scheduleInfo)
+	require.NoError(t, err)
+
+	var decodedScheduleInfo ScheduleInfo
+	require.NoError(t, json.Unmarshal(data, &decodedScheduleInfo))
+	require.NotNil(t, decodedScheduleInfo.ExecutedAt)
+	assert.True(t, executedAt.Equal(*decodedScheduleInfo.ExecutedAt))
+	assert.Nil(t, decodedScheduleInfo.DeletedAt)
+	assert.Equal(t, "schedule", decodedScheduleInfo.Memo)
+}
+
+func TestUnitJSONTransactionReceipt(t *testing.T) {
+	t.Parallel()
+
+	accountID := AccountID{Account: 1001}
+	scheduledTransactionID := testTransactionID.SetScheduled(true)
+	receipt := TransactionReceipt{
+		Status: StatusSuccess,
+		ExchangeRate: &ExchangeRate{
+			Hbars:          30000,
+			cents:          580150,
+			expirationTime: &services.TimestampSeconds{Seconds: 1700000000},
+		},
+		AccountID:              &accountID,
+		TopicRunningHash:       []byte{1, 2, 3},
+		SerialNumbers:          []int64{1, 2},
+		ScheduledTransactionID: &scheduledTransactionID,
+		Children:               []TransactionReceipt{{Status: StatusInvalidSignature}},
+	}
+
+	data, err := json.Marshal(receipt)
+	require.NoError(t, err)
+
+	var decoded TransactionReceipt
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, StatusSuccess, decoded.Status)
+	assert.Equal(t, receipt.ExchangeRate._ToProtobuf().String(), decoded.ExchangeRate._ToProtobuf().String())
+	assert.Nil(t, decoded.NextExchangeRate)
+	assert.Equal(t, "0.0.1001", decoded.AccountID.String())
+	assert.Nil(t, decoded.TopicID)
+	assert.Equal(t, []byte{1, 2, 3}, decoded.TopicRunningHash)
+	assert.Equal(t, scheduledTransactionID.String(), decoded.ScheduledTransactionID.String())
+	require.Len(t, decoded.Children, 1)
+	assert.Equal(t, StatusInvalidSignature, decoded.Children[0].Status)
+
+	decodedData, err := json.Marshal(decoded)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), string(decodedData))
+}
+
+func TestUnitJSONTransactionRecord(t *testing.T) {
+	t.Parallel()
+
+	tokenID := TokenID{Token: 7}
+	record := TransactionRecord{
+		Receipt:            TransactionReceipt{Status: StatusSuccess},
+		TransactionHash:    []byte{0xaa, 0xbb},
+		ConsensusTimestamp: time.Unix(1700000000, 123000000).UTC(),
+		TransactionID:      testTransactionID,
+		TransactionMemo:    "memo",
+		TransactionFee:     HbarFromTinybar(1234),
+		Transfers: []Transfer{
+			{AccountID: AccountID{Account: 2}, Amount: HbarFromTinybar(-10)},
+			{AccountID: AccountID{Account: 3}, Amount: HbarFromTinybar(10)},
+		},
+		TokenTransfers: map[TokenID][]TokenTransfer{
+			tokenID: {
+				{AccountID: AccountID{Account: 2}, Amount: -5},
+				{AccountID: AccountID{Account: 3}, Amount: 5},
+			},
+		},
+		PaidStakingRewards: map[AccountID]Hbar{{Account: 800}: HbarFromTinybar(1)},
+	}
+
+	data, err := json.Marshal(record)
+	require.NoError(t, err)
+
+	var decoded TransactionRecord
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, StatusSuccess, decoded.Receipt.Status)
+	assert.Equal(t, record.TransactionHash, decoded.TransactionHash)
+	assert.True(t, record.ConsensusTimestamp.Equal(decoded.ConsensusTimestamp))
+	assert.Equal(t, record.TransactionID.String(), decoded.TransactionID.String())
+	assert.Equal(t, record.TransactionFee, decoded.TransactionFee)
+	assert.Equal(t, record.Transfers, decoded.Transfers)
+	assert.Equal(t, record.TokenTransfers, decoded.TokenTransfers)
+	assert.Equal(t, record.PaidStakingRewards, decoded.PaidStakingRewards)
+	assert.Nil(t, decoded.AliasKey)
+
+	decodedData, err := json.Marshal(decoded)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), string(decodedData))
+}
+
+func TestUnitJSONTransactionResponse(t *testing.T) {
+	t.Parallel()
+
+	response := TransactionResponse{
+		TransactionID: testTransactionID,
+		NodeID:        AccountID{Account: 3},
+		Hash:          []byte{1, 2, 3},
+	}
+
+	data, err := json.Marshal(response)
+	require.NoError(t, err)
+
+	var decoded TransactionResponse
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, response.NodeID.String(), decoded.NodeID.String())
+	assert.Equal(t, response.Hash, decoded.Hash)
+	assert.Equal(t, response.TransactionID.String(), decoded.TransactionID.String())
+}
+
+func TestUnitJSONTransaction(t *testing.T) {
+	t.Parallel()
+
+	privateKey, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	tx, err := NewTransferTransaction().
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-100)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(100)).
+		SetTransactionID(testTransactionID).
+		SetNodeAccountIDs([]AccountID{{Account: 4}, {Account: 5}}).
+		SetTransactionMemo("json").
+		Freeze()
+	require.NoError(t, err)
+	tx.Sign(privateKey)
+
+	data, err := tx.ToJSON()
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"transactionList"`)
+
+	decoded, err := TransactionFromJSON(data)
+	require.NoError(t, err)
+	transfer, ok := decoded.(TransferTransaction)
+	require.True(t, ok)
+	assert.Equal(t, "json", transfer.GetTransactionMemo())
+
+	expected, err := tx.ToBytes()
+	require.NoError(t, err)
+	actual, err := TransactionToBytes(decoded)
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+
+	staticData, err := TransactionToJSON(decoded)
+	require.NoError(t, err)
+	assert.Equal(t, data, staticData)
+}
@@ -0,0 +1,147 @@
+package mirror
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+)
+
+// Balance is the hbar and token balance of an account at a timestamp.
+type Balance struct {
+	Balance   int64          `json:"balance"`
+	Timestamp Timestamp      `json:"timestamp"`
+	Tokens    []TokenBalance `json:"tokens"`
+}
+
+// TokenBalance is the balance of a single token.
+type TokenBalance struct {
+	TokenID string `json:"token_id"`
+	Balance int64  `json:"balance"`
+}
+
+// Account is an account as returned by /accounts/{idOrAliasOrEvmAddress}.
+type Account struct {
+	Account                       string        `json:"account"`
+	Alias                         *string       `json:"alias"`
+	AutoRenewPeriod               *int64        `json:"auto_renew_period"`
+	Balance                       *Balance      `json:"balance"`
+	CreatedTimestamp              Timestamp     `json:"created_timestamp"`
+	DeclineReward                 bool          `json:"decline_reward"`
+	Deleted                       bool          `json:"deleted"`
+	EthereumNonce                 int64         `json:"ethereum_nonce"`
+	EvmAddress                    string        `json:"evm_address"`
+	ExpiryTimestamp               Timestamp     `json:"expiry_timestamp"`
+	Key                           *Key          `json:"key"`
+	MaxAutomaticTokenAssociations int32         `json:"max_automatic_token_associations"`
+	Memo                          string        `json:"memo"`
+	PendingReward                 int64         `json:"pending_reward"`
+	ReceiverSigRequired           bool          `json:"receiver_sig_required"`
+	StakedAccountID               *string       `json:"staked_account_id"`
+	StakedNodeID                  *int64        `json:"staked_node_id"`
+	StakePeriodStart              *Timestamp    `json:"stake_period_start"`
+	Transactions                  []Transaction `json:"transactions"`
+}
+
+// TokenRelationship is a token associated with an account.
+type TokenRelationship struct {
+	AutomaticAssociation bool      `json:"automatic_association"`
+	Balance              int64     `json:"balance"`
+	CreatedTimestamp     Timestamp `json:"created_timestamp"`
+	Decimals             int64     `json:"decimals"`
+	FreezeStatus         string    `json:"freeze_status"`
+	KycStatus            string    `json:"kyc_status"`
+	TokenID              string    `json:"token_id"`
+}
+
+// StakingReward is a staking reward paid to an account.
+type StakingReward struct {
+	AccountID string    `json:"account_id"`
+	Amount    int64     `json:"amount"`
+	Timestamp Timestamp `json:"timestamp"`
+}
+
+// CryptoAllowance is an hbar allowance granted by an account.
+type CryptoAllowance struct {
+	Amount        int64          `json:"amount"`
+	AmountGranted int64          `json:"amount_granted"`
+	Owner         string         `json:"owner"`
+	Spender       string         `json:"spender"`
+	Timestamp     TimestampRange `json:"timestamp"`
+}
+
+// TokenAllowance is a fungible token allowance granted by an account.
+type TokenAllowance struct {
+	Amount        int64          `json:"amount"`
+	AmountGranted int64          `json:"amount_granted"`
+	Owner         string         `json:"owner"`
+	Spender       string         `json:"spender"`
+	TokenID       string         `json:"token_id"`
+	Timestamp     TimestampRange `json:"timestamp"`
+}
+
+// NftAllowance is an allowance for all serials of a non-fungible token granted by an account.
+type NftAllowance struct {
+	ApprovedForAll bool           `json:"approved_for_all"`
+	Owner          string         `json:"owner"`
+	Spender        string         `json:"spender"`
+	TokenID        string         `json:"token_id"`
+	Timestamp      TimestampRange `json:"timestamp"`
+}
+
+// GetAccount returns the account with the given ID, alias or EVM address.
+func (c *Client) GetAccount(ctx context.Context, idOrAliasOrEvmAddress string) (*Account, error) {
+	var account Account
+	if err := c._Get(ctx, _Path("accounts", idOrAliasOrEvmAddress), nil, &account); err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
+
+// ListAccounts lists the accounts of the network.
+func (c *Client) ListAccounts() *ListRequest[Account] {
+	return _NewListRequest[Account](c, "accounts", "accounts")
+}
+
+// ListAccountTokens lists the tokens associated with an account.
+func (c *Client) ListAccountTokens(idOrAliasOrEvmAddress string) *ListRequest[TokenRelationship] {
+	return _NewListRequest[TokenRelationship](c, _Path("accounts", idOrAliasOrEvmAddress, "tokens"), "tokens")
+}
+
+// ListAccountNfts lists the NFTs owned by an account.
+func (c *Client) ListAccountNfts(idOrAliasOrEvmAddress string) *ListRequest[Nft] {
+	return _NewListRequest[Nft](c, _Path("accounts", idOrAliasOrEvmAddress, "nfts"), "nfts")
+}
+
+// ListAccountRewards lists the staking rewards paid to an account.
+func (c *Client) ListAccountRewards(idOrAliasOrEvmAddress string) *ListRequest[StakingReward] {
+	return _NewListRequest[StakingReward](c, _Path("accounts", idOrAliasOrEvmAddress, "rewards"), "rewards")
+}
+
+// ListCryptoAllowances lists the hbar allowances granted by an account.
+func (c *Client) ListCryptoAllowances(idOrAliasOrEvmAddress string) *ListRequest[CryptoAllowance] {
+	return _NewListRequest[CryptoAllowance](c, _Path("accounts", idOrAliasOrEvmAddress, "allowances", "crypto"), "allowances")
+}
+
+// ListTokenAllowances lists the fungible token allowances granted by an account.
+func (c *Client) ListTokenAllowances(idOrAliasOrEvmAddress string) *ListRequest[TokenAllowance] {
+	return _NewListRequest[TokenAllowance](c, _Path("accounts", idOrAliasOrEvmAddress, "allowances", "tokens"), "allowances")
+}
+
+// ListNftAllowances lists the non-fungible token allowances granted by an account.
+func (c *Client) ListNftAllowances(idOrAliasOrEvmAddress string) *ListRequest[NftAllowance] {
+	return _NewListRequest[NftAllowance](c, _Path("accounts", idOrAliasOrEvmAddress, "allowances", "nfts"), "allowances")
+}
+
+// AccountBalance is the balance of an account as returned by /balances.
+type AccountBalance struct {
+	Account string         `json:"account"`
+	Balance int64          `json:"balance"`
+	Tokens  []TokenBalance `json:"tokens"`
+}
+
+// ListBalances lists the balances of accounts. Use Filter("account.id", ...) to select accounts and
+// Timestamp(OperatorLte, ...) to read historical balances.
+func (c *Client) ListBalances() *ListRequest[AccountBalance] {
+	return _NewListRequest[AccountBalance](c, "balances", "balances")
+}
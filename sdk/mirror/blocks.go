@@ -0,0 +1,36 @@
+package mirror
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+)
+
+// Block is a record file of the network, presented as a block.
+type Block struct {
+	Count        int64          `json:"count"`
+	GasUsed      int64          `json:"gas_used"`
+	HapiVersion  string         `json:"hapi_version"`
+	Hash         string         `json:"hash"`
+	LogsBloom    string         `json:"logs_bloom"`
+	Name         string         `json:"name"`
+	Number       int64          `json:"number"`
+	PreviousHash string         `json:"previous_hash"`
+	Size         int64          `json:"size"`
+	Timestamp    TimestampRange `json:"timestamp"`
+}
+
+// GetBlock returns the block with the given hash or number.
+func (c *Client) GetBlock(ctx context.Context, hashOrNumber string) (*Block, error) {
+	var block Block
+	if err := c._Get(ctx, _Path("blocks", hashOrNumber), nil, &block); err != nil {
+		return nil, err
+	}
+
+	return &block, nil
+}
+
+// ListBlocks lists the blocks of the network.
+func (c *Client) ListBlocks() *ListRequest[Block] {
+	return _NewListRequest[Block](c, "blocks", "blocks")
+}
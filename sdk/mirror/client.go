@@ -0,0 +1,427 @@
+// Package mirror is a client for the REST API of a Hiero mirror node.
+//
+// Entities are identified by their string form, eg. "0.0.1234" for accounts, tokens, topics, contracts and schedules,
+// or "0.0.2-1700000000-000000001" for transaction IDs, so the package can be used without the SDK types. Lists follow
+// the `links.next` field of each page and are returned as iterators, which can be used with range-over-func or
+// consumed with Iterator.Collect.
+package mirror
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Client sends requests to the REST API of a single mirror node.
+//
+// Requests answered with status 429 or 5xx are retried up to the maximum number of attempts, waiting for the
+// Retry-After of the response or an exponential backoff between attempts.
+type Client struct {
+	baseURL        *url.URL
+	httpClient     *http.Client
+	headers        http.Header
+	requestTimeout time.Duration
+	maxAttempts    int
+	minBackoff     time.Duration
+	maxBackoff     time.Duration
+}
+
+// NewClient creates a client for the REST API at baseURL, eg. "https://testnet.mirrornode.hedera.com/api/v1".
+func NewClient(baseURL string) (*Client, error) {
+	parsed, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, err
+	}
+	if parsed.Scheme == "" || parsed.Host == "" {
+		return nil, fmt.Errorf("mirror node URL %q must include a scheme and a host", baseURL)
+	}
+	if !strings.HasSuffix(parsed.Path, "/") {
+		parsed.Path += "/"
+	}
+
+	return &Client{
+		baseURL:        parsed,
+		httpClient:     http.DefaultClient,
+		headers:        http.Header{},
+		requestTimeout: 30 * time.Second,
+		maxAttempts:    5,
+		minBackoff:     250 * time.Millisecond,
+		maxBackoff:     8 * time.Second,
+	}, nil
+}
+
+// SetHTTPClient sets the HTTP client used to send requests. http.DefaultClient is used by default.
+func (c *Client) SetHTTPClient(httpClient *http.Client) *Client {
+	c.httpClient = httpClient
+	return c
+}
+
+// GetHTTPClient returns the HTTP client used to send requests.
+func (c *Client) GetHTTPClient() *http.Client {
+	return c.httpClient
+}
+
+// SetHeader sets a header sent with every request, eg. the API key of a commercial mirror node provider.
+func (c *Client) SetHeader(key string, value string) *Client {
+	c.headers.Set(key, value)
+	return c
+}
+
+// AddHeader adds a value to a header sent with every request, keeping the values it already has.
+func (c *Client) AddHeader(key string, value string) *Client {
+	c.headers.Add(key, value)
+	return c
+}
+
+// GetHeaders returns the headers sent with every request.
+func (c *Client) GetHeaders() http.Header {
+	return c.headers.Clone()
+}
+
+// SetRequestTimeout sets the timeout of a single attempt of a request. Zero disables the timeout, in which case only
+// the context of the request bounds it. The default is 30 seconds.
+func (c *Client) SetRequestTimeout(timeout time.Duration) *Client {
+	c.requestTimeout = timeout
+	return c
+}
+
+// GetRequestTimeout returns the timeout of a single attempt of a request.
+func (c *Client) GetRequestTimeout() time.Duration {
+	return c.requestTimeout
+}
+
+// SetMaxAttempts sets the maximum number of attempts of a request answered with status 429 or 5xx. The default is 5.
+func (c *Client) SetMaxAttempts(maxAttempts int) *Client {
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	c.maxAttempts = maxAttempts
+	return c
+}
+
+// GetMaxAttempts returns the maximum number of attempts of a request.
+func (c *Client) GetMaxAttempts() int {
+	return c.maxAttempts
+}
+
+// SetMinBackoff sets the wait before the first retry when the response has no Retry-After header.
+func (c *Client) SetMinBackoff(minBackoff time.Duration) *Client {
+	c.minBackoff = minBackoff
+	return c
+}
+
+// GetMinBackoff returns the wait before the first retry.
+func (c *Client) GetMinBackoff() time.Duration {
+	return c.minBackoff
+}
+
+// SetMaxBackoff sets the maximum wait between retries. The wait doubles after every attempt until it reaches it.
+func (c *Client) SetMaxBackoff(maxBackoff time.Duration) *Client {
+	c.maxBackoff = maxBackoff
+	return c
+}
+
+// GetMaxBackoff returns the maximum wait between retries.
+func (c *Client) GetMaxBackoff() time.Duration {
+	return c.maxBackoff
+}
+
+// GetBaseURL returns the base URL of the REST API.
+func (c *Client) GetBaseURL() string {
+	return c.baseURL.String()
+}
+
+// Error is returned when the mirror node responds with a non 2xx status code.
+type Error struct {
+	StatusCode int
+	Messages   []string
+	// Data is the hex encoded data of the first message which has some, eg. the revert data of a contract call
+	Data string
+}
+
+// Error() implements the Error interface
+func (e *Error) Error() string {
+	if len(e.Messages) == 0 {
+		return fmt.Sprintf("mirror node responded with status %d", e.StatusCode)
+	}
+
+	return fmt.Sprintf("mirror node responded with status %d: %s", e.StatusCode, strings.Join(e.Messages, ", "))
+}
+
+// IsNotFound reports whether err is a mirror node response with status 404.
+func IsNotFound(err error) bool {
+	mirrorErr, ok := err.(*Error)
+	return ok && mirrorErr.StatusCode == http.StatusNotFound
+}
+
+func _Path(segments ...string) string {
+	escaped := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		escaped = append(escaped, url.PathEscape(segment))
+	}
+
+	return strings.Join(escaped, "/")
+}
+
+// _Resolve resolves a path relative to the base URL, or an absolute path such as the `links.next` of a page.
+func (c *Client) _Resolve(path string, query url.Values) (string, error) {
+	reference, err := url.Parse(path)
+	if err != nil {
+		return "", err
+	}
+
+	if strings.HasPrefix(reference.Path, "/") {
+		reference.Path = c._PathPrefix(reference.Path) + reference.Path
+		reference.RawPath = ""
+	}
+
+	resolved := c.baseURL.ResolveReference(reference)
+	if len(query) > 0 {
+		resolved.RawQuery = query.Encode()
+	}
+
+	return resolved.String(), nil
+}
+
+// _PathPrefix returns the part of the base path in front of an absolute path, eg. /prefix for the base URL
+// https://gateway/prefix/api/v1 and the path /api/v1/accounts. The mirror node builds absolute paths from its own
+// root, so it doesn't know about a prefix added by a gateway in front of it.
+func (c *Client) _PathPrefix(path string) string {
+	basePath := strings.Trim(c.baseURL.Path, "/")
+	if basePath == "" {
+		return ""
+	}
+
+	base := strings.Split(basePath, "/")
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	for start := range base {
+		overlap := base[start:]
+		if len(overlap) > len(segments) {
+			continue
+		}
+
+		matches := true
+		for i := range overlap {
+			if overlap[i] != segments[i] {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			if start == 0 {
+				return ""
+			}
+			return "/" + strings.Join(base[:start], "/")
+		}
+	}
+
+	return ""
+}
+
+func (c *Client) _Get(ctx context.Context, path string, query url.Values, out interface{}) error {
+	return c._Do(ctx, http.MethodGet, path, query, nil, out)
+}
+
+func (c *Client) _Post(ctx context.Context, path string, body interface{}, out interface{}) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	return c._Do(ctx, http.MethodPost, path, nil, data, out)
+}
+
+func (c *Client) _Do(ctx context.Context, method string, path string, query url.Values, body []byte, out interface{}) error {
+	target, err := c._Resolve(path, query)
+	if err != nil {
+		return err
+	}
+
+	for attempt := 1; ; attempt++ {
+		statusCode, header, data, err := c._Attempt(ctx, method, target, body)
+		if err != nil {
+			return err
+		}
+
+		if statusCode >= 200 && statusCode <= 299 {
+			if out == nil {
+				return nil
+			}
+
+			return json.Unmarshal(data, out)
+		}
+
+		if attempt >= c.maxAttempts || !_ShouldRetry(statusCode) {
+			return _ErrorFromResponse(statusCode, data)
+		}
+
+		timer := time.NewTimer(c._Delay(attempt, header.Get("Retry-After")))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// _Attempt sends a single request and reads its response within the request timeout.
+func (c *Client) _Attempt(ctx context.Context, method string, target string, body []byte) (int, http.Header, []byte, error) {
+	if c.requestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.requestTimeout)
+		defer cancel()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, target, reader)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	for key, values := range c.headers {
+		request.Header[key] = append([]string(nil), values...)
+	}
+	request.Header.Set("Accept", "application/json")
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	defer response.Body.Close()
+
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	return response.StatusCode, response.Header, data, nil
+}
+
+func _ShouldRetry(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
+
+// _Delay returns the wait before the next attempt: the Retry-After of the response, in seconds or as an HTTP date,
+// or else the backoff of the attempt.
+func (c *Client) _Delay(attempt int, retryAfter string) time.Duration {
+	if retryAfter != "" {
+		if seconds, err := strconv.ParseInt(retryAfter, 10, 64); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second
+		}
+		if date, err := http.ParseTime(retryAfter); err == nil {
+			if delay := time.Until(date); delay > 0 {
+				return delay
+			}
+			return 0
+		}
+	}
+
+	delay := c.minBackoff
+	for i := 1; i < attempt && delay < c.maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > c.maxBackoff {
+		delay = c.maxBackoff
+	}
+
+	return delay
+}
+
+func _ErrorFromResponse(statusCode int, data []byte) *Error {
+	var body struct {
+		Status struct {
+			Messages []struct {
+				Message string `json:"message"`
+				Detail  string `json:"detail"`
+				Data    string `json:"data"`
+			} `json:"messages"`
+		} `json:"_status"`
+	}
+
+	mirrorErr := &Error{StatusCode: statusCode}
+	if err := json.Unmarshal(data, &body); err != nil {
+		if text := strings.TrimSpace(string(data)); text != "" {
+			mirrorErr.Messages = []string{text}
+		}
+		return mirrorErr
+	}
+
+	for _, message := range body.Status.Messages {
+		if mirrorErr.Data == "" {
+			mirrorErr.Data = message.Data
+		}
+		if message.Detail != "" {
+			mirrorErr.Messages = append(mirrorErr.Messages, message.Message+" ("+message.Detail+")")
+		} else {
+			mirrorErr.Messages = append(mirrorErr.Messages, message.Message)
+		}
+	}
+
+	return mirrorErr
+}
+
+// Timestamp is a consensus timestamp in the "seconds.nanoseconds" form used by the mirror node.
+type Timestamp string
+
+// TimestampFromTime returns the mirror node form of t.
+func TimestampFromTime(t time.Time) Timestamp {
+	return Timestamp(fmt.Sprintf("%d.%09d", t.Unix(), t.Nanosecond()))
+}
+
+// Time parses the timestamp. An empty timestamp is the zero time.
+func (t Timestamp) Time() (time.Time, error) {
+	if t == "" {
+		return time.Time{}, nil
+	}
+
+	secondsPart, nanosPart, _ := strings.Cut(string(t), ".")
+	seconds, err := strconv.ParseInt(secondsPart, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timestamp %q", string(t))
+	}
+
+	var nanos int64
+	if nanosPart != "" {
+		if len(nanosPart) > 9 {
+			return time.Time{}, fmt.Errorf("invalid timestamp %q", string(t))
+		}
+		if nanos, err = strconv.ParseInt((nanosPart + "000000000")[:9], 10, 64); err != nil {
+			return time.Time{}, fmt.Errorf("invalid timestamp %q", string(t))
+		}
+	}
+
+	return time.Unix(seconds, nanos), nil
+}
+
+// String returns the timestamp in the "seconds.nanoseconds" form.
+func (t Timestamp) String() string {
+	return string(t)
+}
+
+// TimestampRange is the period an entity, or a version of it, was valid for. To is empty for the current version.
+type TimestampRange struct {
+	From Timestamp `json:"from"`
+	To   Timestamp `json:"to"`
+}
+
+// Key is a key as returned by the mirror node, eg. {"_type": "ED25519", "key": "<hex>"}.
+type Key struct {
+	Type string `json:"_type"`
+	Key  string `json:"key"`
+}
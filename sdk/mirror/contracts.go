@@ -0,0 +1,182 @@
+package mirror
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+)
+
+// Contract is a contract as returned by /contracts/{idOrEvmAddress}. The bytecodes are only returned for a single
+// contract and are hex encoded.
+type Contract struct {
+	AdminKey                      *Key           `json:"admin_key"`
+	AutoRenewAccount              *string        `json:"auto_renew_account"`
+	AutoRenewPeriod               *int64         `json:"auto_renew_period"`
+	Bytecode                      *string        `json:"bytecode"`
+	ContractID                    string         `json:"contract_id"`
+	CreatedTimestamp              Timestamp      `json:"created_timestamp"`
+	Deleted                       bool           `json:"deleted"`
+	EvmAddress                    string         `json:"evm_address"`
+	ExpirationTimestamp           *Timestamp     `json:"expiration_timestamp"`
+	FileID                        *string        `json:"file_id"`
+	MaxAutomaticTokenAssociations int32          `json:"max_automatic_token_associations"`
+	Memo                          string         `json:"memo"`
+	Nonce                         *int64         `json:"nonce"`
+	ObtainerID                    *string        `json:"obtainer_id"`
+	PermanentRemoval              *bool          `json:"permanent_removal"`
+	ProxyAccountID                *string        `json:"proxy_account_id"`
+	RuntimeBytecode               *string        `json:"runtime_bytecode"`
+	Timestamp                     TimestampRange `json:"timestamp"`
+}
+
+// ContractLog is a log emitted by a contract. The data and topics are hex encoded.
+type ContractLog struct {
+	Address          string    `json:"address"`
+	Bloom            string    `json:"bloom"`
+	ContractID       string    `json:"contract_id"`
+	Data             string    `json:"data"`
+	Index            int64     `json:"index"`
+	Topics           []string  `json:"topics"`
+	BlockHash        string    `json:"block_hash"`
+	BlockNumber      int64     `json:"block_number"`
+	RootContractID   *string   `json:"root_contract_id"`
+	Timestamp        Timestamp `json:"timestamp"`
+	TransactionHash  string    `json:"transaction_hash"`
+	TransactionIndex *int64    `json:"transaction_index"`
+}
+
+// ContractStateChange is a storage slot read or written by a contract call.
+type ContractStateChange struct {
+	Address      string  `json:"address"`
+	ContractID   string  `json:"contract_id"`
+	Slot         string  `json:"slot"`
+	ValueRead    string  `json:"value_read"`
+	ValueWritten *string `json:"value_written"`
+}
+
+// ContractResult is the result of a contract call or creation. Logs and StateChanges are only returned by
+// /contracts/results/{transactionIdOrHash}.
+type ContractResult struct {
+	AccessList           string                `json:"access_list"`
+	Address              string                `json:"address"`
+	Amount               int64                 `json:"amount"`
+	BlockGasUsed         int64                 `json:"block_gas_used"`
+	BlockHash            string                `json:"block_hash"`
+	BlockNumber          int64                 `json:"block_number"`
+	Bloom                string                `json:"bloom"`
+	CallResult           string                `json:"call_result"`
+	ChainID              string                `json:"chain_id"`
+	ContractID           string                `json:"contract_id"`
+	CreatedContractIDs   []string              `json:"created_contract_ids"`
+	ErrorMessage         *string               `json:"error_message"`
+	FailedInitcode       *string               `json:"failed_initcode"`
+	From                 string                `json:"from"`
+	FunctionParameters   string                `json:"function_parameters"`
+	GasConsumed          *int64                `json:"gas_consumed"`
+	GasLimit             int64                 `json:"gas_limit"`
+	GasPrice             string                `json:"gas_price"`
+	GasUsed              int64                 `json:"gas_used"`
+	Hash                 string                `json:"hash"`
+	Logs                 []ContractLog         `json:"logs"`
+	MaxFeePerGas         string                `json:"max_fee_per_gas"`
+	MaxPriorityFeePerGas string                `json:"max_priority_fee_per_gas"`
+	Nonce                int64                 `json:"nonce"`
+	R                    string                `json:"r"`
+	Result               string                `json:"result"`
+	S                    string                `json:"s"`
+	StateChanges         []ContractStateChange `json:"state_changes"`
+	Status               string                `json:"status"`
+	Timestamp            Timestamp             `json:"timestamp"`
+	To                   string                `json:"to"`
+	TransactionIndex     int64                 `json:"transaction_index"`
+	Type                 *int32                `json:"type"`
+	V                    int64                 `json:"v"`
+}
+
+// ContractState is the value of a storage slot of a contract.
+type ContractState struct {
+	Address    string    `json:"address"`
+	ContractID string    `json:"contract_id"`
+	Timestamp  Timestamp `json:"timestamp"`
+	Slot       string    `json:"slot"`
+	Value      string    `json:"value"`
+}
+
+// ContractCallRequest is the body of a /contracts/call request. Data and the addresses are hex encoded.
+type ContractCallRequest struct {
+	Data        string  `json:"data"`
+	To          *string `json:"to"`
+	Estimate    bool    `json:"estimate"`
+	BlockNumber string  `json:"blockNumber"`
+	From        *string `json:"from,omitempty"`
+	Gas         *int64  `json:"gas,omitempty"`
+	GasPrice    *int64  `json:"gasPrice,omitempty"`
+	Value       *int64  `json:"value,omitempty"`
+}
+
+// ContractCallResponse is the result of a /contracts/call request, hex encoded.
+type ContractCallResponse struct {
+	Result string `json:"result"`
+}
+
+// GetContract returns the contract with the given ID or EVM address.
+func (c *Client) GetContract(ctx context.Context, idOrEvmAddress string) (*Contract, error) {
+	var contract Contract
+	if err := c._Get(ctx, _Path("contracts", idOrEvmAddress), nil, &contract); err != nil {
+		return nil, err
+	}
+
+	return &contract, nil
+}
+
+// ListContracts lists the contracts of the network.
+func (c *Client) ListContracts() *ListRequest[Contract] {
+	return _NewListRequest[Contract](c, "contracts", "contracts")
+}
+
+// ListContractResults lists the results of the calls to a contract.
+func (c *Client) ListContractResults(idOrEvmAddress string) *ListRequest[ContractResult] {
+	return _NewListRequest[ContractResult](c, _Path("contracts", idOrEvmAddress, "results"), "results")
+}
+
+// ListAllContractResults lists the results of the contract calls of the network.
+func (c *Client) ListAllContractResults() *ListRequest[ContractResult] {
+	return _NewListRequest[ContractResult](c, _Path("contracts", "results"), "results")
+}
+
+// GetContractResult returns the result of the contract call with the given transaction ID or ethereum
+// transaction hash, including its logs and state changes.
+func (c *Client) GetContractResult(ctx context.Context, transactionIDOrHash string) (*ContractResult, error) {
+	var result ContractResult
+	if err := c._Get(ctx, _Path("contracts", "results", transactionIDOrHash), nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListContractLogs lists the logs emitted by a contract. Use Filter("topic0", OperatorEq, ...) to select an event.
+func (c *Client) ListContractLogs(idOrEvmAddress string) *ListRequest[ContractLog] {
+	return _NewListRequest[ContractLog](c, _Path("contracts", idOrEvmAddress, "results", "logs"), "logs")
+}
+
+// ListAllContractLogs lists the logs emitted by all contracts of the network.
+func (c *Client) ListAllContractLogs() *ListRequest[ContractLog] {
+	return _NewListRequest[ContractLog](c, _Path("contracts", "results", "logs"), "logs")
+}
+
+// ListContractState lists the storage slots of a contract.
+func (c *Client) ListContractState(idOrEvmAddress string) *ListRequest[ContractState] {
+	return _NewListRequest[ContractState](c, _Path("contracts", idOrEvmAddress, "state"), "state")
+}
+
+// CallContract simulates a contract call, or estimates its gas when request.Estimate is set, without submitting
+// a transaction.
+func (c *Client) CallContract(ctx context.Context, request ContractCallRequest) (*ContractCallResponse, error) {
+	var response ContractCallResponse
+	if err := c._Post(ctx, _Path("contracts", "call"), request, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,181 @@
+package mirror
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+// Iterator yields the items of a list, fetching the following pages on demand. The iterator stops after yielding
+// an error. With Go 1.23 or later it can be used directly with range, eg.
+//
+//	for account, err := range client.ListAccounts().Iterate(ctx) {
+//		...
+//	}
+type Iterator[T any] func(yield func(T, error) bool)
+
+// Collect returns all the items of the iterator, or the first error.
+func (it Iterator[T]) Collect() ([]T, error) {
+	items := make([]T, 0)
+	var iterErr error
+	it(func(item T, err error) bool {
+		if err != nil {
+			iterErr = err
+			return false
+		}
+		items = append(items, item)
+		return true
+	})
+
+	return items, iterErr
+}
+
+// Order is the order of the items of a list.
+type Order string
+
+const (
+	OrderAsc  Order = "asc"
+	OrderDesc Order = "desc"
+)
+
+// Operator compares a query parameter against a value.
+type Operator string
+
+const (
+	OperatorEq  Operator = "eq"
+	OperatorNe  Operator = "ne"
+	OperatorLt  Operator = "lt"
+	OperatorLte Operator = "lte"
+	OperatorGt  Operator = "gt"
+	OperatorGte Operator = "gte"
+)
+
+// Links are the pagination links of a page.
+type Links struct {
+	Next *string `json:"next"`
+}
+
+// ListRequest builds a request for a paginated list.
+type ListRequest[T any] struct {
+	client   *Client
+	path     string
+	itemsKey string
+	query    url.Values
+}
+
+func _NewListRequest[T any](client *Client, path string, itemsKey string) *ListRequest[T] {
+	return &ListRequest[T]{
+		client:   client,
+		path:     path,
+		itemsKey: itemsKey,
+		query:    url.Values{},
+	}
+}
+
+// Limit sets the maximum number of items of each page.
+func (r *ListRequest[T]) Limit(limit int) *ListRequest[T] {
+	r.query.Set("limit", strconv.Itoa(limit))
+	return r
+}
+
+// Order sets the order of the items.
+func (r *ListRequest[T]) Order(order Order) *ListRequest[T] {
+	r.query.Set("order", string(order))
+	return r
+}
+
+// Filter adds a filter on a query parameter, eg. Filter("account.id", OperatorGte, "0.0.1000").
+// Filters on the same parameter can be combined to select a range.
+func (r *ListRequest[T]) Filter(parameter string, operator Operator, value string) *ListRequest[T] {
+	r.query.Add(parameter, string(operator)+":"+value)
+	return r
+}
+
+// Timestamp adds a filter on the consensus timestamp of the items.
+func (r *ListRequest[T]) Timestamp(operator Operator, timestamp Timestamp) *ListRequest[T] {
+	return r.Filter("timestamp", operator, timestamp.String())
+}
+
+// Param sets a query parameter which is not a comparison, eg. Param("transactiontype", "CRYPTOTRANSFER").
+func (r *ListRequest[T]) Param(parameter string, value string) *ListRequest[T] {
+	r.query.Set(parameter, value)
+	return r
+}
+
+// AddParam adds a value to a query parameter which can be repeated, eg. AddParam("topic0", ...) for each of the
+// event signatures to select.
+func (r *ListRequest[T]) AddParam(parameter string, value string) *ListRequest[T] {
+	r.query.Add(parameter, value)
+	return r
+}
+
+// Page fetches a single page and returns its items and the link of the next page, which is nil on the last page.
+func (r *ListRequest[T]) Page(ctx context.Context) ([]T, *string, error) {
+	return r._Page(ctx, r.path, r.query)
+}
+
+// PageAt fetches the page at a next link returned with a previous page. The link holds the complete query, so the
+// filters of the request are ignored, and it can be followed on another mirror node.
+func (r *ListRequest[T]) PageAt(ctx context.Context, next string) ([]T, *string, error) {
+	return r._Page(ctx, next, nil)
+}
+
+func (r *ListRequest[T]) _Page(ctx context.Context, path string, query url.Values) ([]T, *string, error) {
+	var page map[string]json.RawMessage
+	if err := r.client._Get(ctx, path, query, &page); err != nil {
+		return nil, nil, err
+	}
+
+	var items []T
+	if data, ok := page[r.itemsKey]; ok {
+		if err := json.Unmarshal(data, &items); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var links Links
+	if data, ok := page["links"]; ok {
+		if err := json.Unmarshal(data, &links); err != nil {
+			return nil, nil, err
+		}
+	}
+	if links.Next != nil && *links.Next == "" {
+		links.Next = nil
+	}
+
+	return items, links.Next, nil
+}
+
+// Iterate returns an iterator over the items of all pages.
+func (r *ListRequest[T]) Iterate(ctx context.Context) Iterator[T] {
+	return func(yield func(T, error) bool) {
+		path := r.path
+		query := r.query
+
+		for {
+			items, next, err := r._Page(ctx, path, query)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if next == nil {
+				return
+			}
+
+			// The next link holds the complete query of the following page
+			path = *next
+			query = nil
+		}
+	}
+}
@@ -0,0 +1,242 @@
+//go:build all || unit
+// +build all unit
+
+package mirror
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitMirrorListFollowsNextLinks(t *testing.T) {
+	t.Parallel()
+
+	var mutex sync.Mutex
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/accounts/0.0.2/tokens", r.URL.Path)
+		mutex.Lock()
+		queries = append(queries, r.URL.RawQuery)
+		mutex.Unlock()
+
+		if r.URL.Query().Get("token.id") == "" {
+			_, _ = w.Write([]byte(`{"tokens":[{"token_id":"0.0.5","balance":10},{"token_id":"0.0.6","balance":20}],
+				"links":{"next":"/api/v1/accounts/0.0.2/tokens?limit=2&token.id=gt:0.0.6"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"tokens":[{"token_id":"0.0.7","balance":30}],"links":{"next":null}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL + "/api/v1")
+	require.NoError(t, err)
+
+	tokens, err := client.ListAccountTokens("0.0.2").Limit(2).Order(OrderAsc).Iterate(context.Background()).Collect()
+	require.NoError(t, err)
+	require.Len(t, tokens, 3)
+	assert.Equal(t, "0.0.7", tokens[2].TokenID)
+	assert.Equal(t, int64(30), tokens[2].Balance)
+	mutex.Lock()
+	assert.Equal(t, []string{"limit=2&order=asc", "limit=2&token.id=gt:0.0.6"}, queries)
+	queries = nil
+	mutex.Unlock()
+
+	// Stopping early doesn't fetch the following pages
+	client.ListAccountTokens("0.0.2").Iterate(context.Background())(func(token TokenRelationship, err error) bool {
+		require.NoError(t, err)
+		return false
+	})
+	mutex.Lock()
+	assert.Len(t, queries, 1)
+	mutex.Unlock()
+}
+
+func TestUnitMirrorError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"_status":{"messages":[{"message":"Not found"}]}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL + "/api/v1")
+	require.NoError(t, err)
+
+	_, err = client.GetToken(context.Background(), "0.0.5")
+	require.Error(t, err)
+	assert.True(t, IsNotFound(err))
+	assert.Equal(t, []string{"Not found"}, err.(*Error).Messages)
+
+	_, err = client.ListBalances().Filter("account.id", OperatorEq, "0.0.2").Iterate(context.Background()).Collect()
+	assert.True(t, IsNotFound(err))
+}
+
+func TestUnitMirrorCallContract(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v1/contracts/call", r.URL.Path)
+
+		var request map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		assert.Equal(t, "abcd", request["data"])
+		assert.Equal(t, true, request["estimate"])
+		assert.NotContains(t, request, "from")
+
+		_, _ = w.Write([]byte(`{"result":"0x5208"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL + "/api/v1/")
+	require.NoError(t, err)
+
+	to := "0x0000000000000000000000000000000000000405"
+	response, err := client.CallContract(context.Background(), ContractCallRequest{
+		Data:        "abcd",
+		To:          &to,
+		Estimate:    true,
+		BlockNumber: "latest",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "0x5208", response.Result)
+}
+
+func TestUnitMirrorTimestamp(t *testing.T) {
+	t.Parallel()
+
+	timestamp := Timestamp("1700000000.000000123")
+	parsed, err := timestamp.Time()
+	require.NoError(t, err)
+	assert.True(t, time.Unix(1700000000, 123).Equal(parsed))
+	assert.Equal(t, timestamp, TimestampFromTime(parsed))
+
+	parsed, err = Timestamp("1700000000.5").Time()
+	require.NoError(t, err)
+	assert.Equal(t, 500000000, parsed.Nanosecond())
+
+	_, err = Timestamp("not a timestamp").Time()
+	require.Error(t, err)
+
+	_, err = NewClient("testnet.mirrornode.hedera.com")
+	require.Error(t, err)
+}
+
+func TestUnitMirrorRetriesWithHeaders(t *testing.T) {
+	t.Parallel()
+
+	var mutex sync.Mutex
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "secret", r.Header.Get("X-Api-Key"))
+
+		mutex.Lock()
+		attempts++
+		attempt := attempts
+		mutex.Unlock()
+
+		switch attempt {
+		case 1:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			_, _ = w.Write([]byte(`{"token_id":"0.0.5","name":"ffff"}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL + "/api/v1")
+	require.NoError(t, err)
+	client.SetHeader("X-Api-Key", "secret").SetMinBackoff(time.Millisecond).SetMaxBackoff(time.Millisecond)
+
+	token, err := client.GetToken(context.Background(), "0.0.5")
+	require.NoError(t, err)
+	assert.Equal(t, "ffff", token.Name)
+	mutex.Lock()
+	assert.Equal(t, 3, attempts)
+	attempts = 0
+	mutex.Unlock()
+
+	// The last response is returned once the attempts are exhausted
+	client.SetMaxAttempts(2)
+	_, err = client.GetToken(context.Background(), "0.0.5")
+	require.Error(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, err.(*Error).StatusCode)
+	mutex.Lock()
+	assert.Equal(t, 2, attempts)
+	mutex.Unlock()
+}
+
+func TestUnitMirrorRequestTimeout(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL + "/api/v1")
+	require.NoError(t, err)
+	client.SetRequestTimeout(50 * time.Millisecond)
+
+	start := time.Now()
+	_, err = client.GetToken(context.Background(), "0.0.5")
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestUnitMirrorRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	client, err := NewClient("https://testnet.mirrornode.hedera.com/api/v1")
+	require.NoError(t, err)
+	client.SetMinBackoff(time.Second).SetMaxBackoff(3 * time.Second)
+
+	assert.Equal(t, time.Second, client._Delay(1, ""))
+	assert.Equal(t, 2*time.Second, client._Delay(2, ""))
+	assert.Equal(t, 3*time.Second, client._Delay(5, ""))
+	assert.Equal(t, 7*time.Second, client._Delay(1, "7"))
+	assert.Equal(t, time.Duration(0), client._Delay(1, time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat)))
+	assert.Equal(t, time.Second, client._Delay(1, "soon"))
+}
+
+func TestUnitMirrorResolveKeepsPathPrefix(t *testing.T) {
+	t.Parallel()
+
+	client, err := NewClient("https://gateway.example.com/prefix/api/v1")
+	require.NoError(t, err)
+
+	for path, expected := range map[string]string{
+		"accounts/0.0.2":                        "https://gateway.example.com/prefix/api/v1/accounts/0.0.2",
+		"/api/v1/accounts?limit=2":              "https://gateway.example.com/prefix/api/v1/accounts?limit=2",
+		"/prefix/api/v1/accounts?limit=2":       "https://gateway.example.com/prefix/api/v1/accounts?limit=2",
+		"/health":                               "https://gateway.example.com/health",
+		"/api/v1/tokens/0.0.5/nfts?limit=1&a=b": "https://gateway.example.com/prefix/api/v1/tokens/0.0.5/nfts?limit=1&a=b",
+	} {
+		resolved, err := client._Resolve(path, nil)
+		require.NoError(t, err)
+		assert.Equal(t, expected, resolved, path)
+	}
+
+	client, err = NewClient("https://mirror.example.com/api/v1")
+	require.NoError(t, err)
+	resolved, err := client._Resolve("/api/v1/accounts?limit=2", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "https://mirror.example.com/api/v1/accounts?limit=2", resolved)
+}
@@ -0,0 +1,105 @@
+package mirror
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+)
+
+// NetworkFee is the gas price of a transaction type, in tinybars.
+type NetworkFee struct {
+	Gas             int64  `json:"gas"`
+	TransactionType string `json:"transaction_type"`
+}
+
+// NetworkFees are the gas prices of the network at a timestamp.
+type NetworkFees struct {
+	Fees      []NetworkFee `json:"fees"`
+	Timestamp Timestamp    `json:"timestamp"`
+}
+
+// NetworkSupply is the hbar supply of the network, in tinybars.
+type NetworkSupply struct {
+	ReleasedSupply string    `json:"released_supply"`
+	Timestamp      Timestamp `json:"timestamp"`
+	TotalSupply    string    `json:"total_supply"`
+}
+
+// ExchangeRate is the hbar to USD cent exchange rate. ExpirationTime is in seconds.
+type ExchangeRate struct {
+	CentEquivalent int32 `json:"cent_equivalent"`
+	ExpirationTime int64 `json:"expiration_time"`
+	HbarEquivalent int32 `json:"hbar_equivalent"`
+}
+
+// NetworkExchangeRate is the current and next exchange rate of the network.
+type NetworkExchangeRate struct {
+	CurrentRate ExchangeRate `json:"current_rate"`
+	NextRate    ExchangeRate `json:"next_rate"`
+	Timestamp   Timestamp    `json:"timestamp"`
+}
+
+// ServiceEndpoint is an endpoint of a consensus node.
+type ServiceEndpoint struct {
+	DomainName  string `json:"domain_name"`
+	IPAddressV4 string `json:"ip_address_v4"`
+	Port        int32  `json:"port"`
+}
+
+// NetworkNode is a consensus node of the network.
+type NetworkNode struct {
+	AdminKey          *Key              `json:"admin_key"`
+	DeclineReward     bool              `json:"decline_reward"`
+	Description       string            `json:"description"`
+	FileID            string            `json:"file_id"`
+	GrpcProxyEndpoint *ServiceEndpoint  `json:"grpc_proxy_endpoint"`
+	MaxStake          int64             `json:"max_stake"`
+	Memo              string            `json:"memo"`
+	MinStake          int64             `json:"min_stake"`
+	NodeAccountID     string            `json:"node_account_id"`
+	NodeCertHash      string            `json:"node_cert_hash"`
+	NodeID            int64             `json:"node_id"`
+	PublicKey         string            `json:"public_key"`
+	RewardRateStart   int64             `json:"reward_rate_start"`
+	ServiceEndpoints  []ServiceEndpoint `json:"service_endpoints"`
+	Stake             int64             `json:"stake"`
+	StakeNotRewarded  int64             `json:"stake_not_rewarded"`
+	StakeRewarded     int64             `json:"stake_rewarded"`
+	StakingPeriod     *TimestampRange   `json:"staking_period"`
+	Timestamp         TimestampRange    `json:"timestamp"`
+}
+
+// GetNetworkFees returns the gas prices of the network.
+func (c *Client) GetNetworkFees(ctx context.Context) (*NetworkFees, error) {
+	var fees NetworkFees
+	if err := c._Get(ctx, _Path("network", "fees"), nil, &fees); err != nil {
+		return nil, err
+	}
+
+	return &fees, nil
+}
+
+// GetNetworkSupply returns the hbar supply of the network.
+func (c *Client) GetNetworkSupply(ctx context.Context) (*NetworkSupply, error) {
+	var supply NetworkSupply
+	if err := c._Get(ctx, _Path("network", "supply"), nil, &supply); err != nil {
+		return nil, err
+	}
+
+	return &supply, nil
+}
+
+// GetNetworkExchangeRate returns the exchange rate of the network.
+func (c *Client) GetNetworkExchangeRate(ctx context.Context) (*NetworkExchangeRate, error) {
+	var rate NetworkExchangeRate
+	if err := c._Get(ctx, _Path("network", "exchangerate"), nil, &rate); err != nil {
+		return nil, err
+	}
+
+	return &rate, nil
+}
+
+// ListNetworkNodes lists the consensus nodes of the network.
+func (c *Client) ListNetworkNodes() *ListRequest[NetworkNode] {
+	return _NewListRequest[NetworkNode](c, _Path("network", "nodes"), "nodes")
+}
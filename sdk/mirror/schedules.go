@@ -0,0 +1,46 @@
+package mirror
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+)
+
+// ScheduleSignature is a signature collected by a schedule. The prefix and signature are base64 encoded.
+type ScheduleSignature struct {
+	ConsensusTimestamp Timestamp `json:"consensus_timestamp"`
+	PublicKeyPrefix    string    `json:"public_key_prefix"`
+	Signature          string    `json:"signature"`
+	Type               string    `json:"type"`
+}
+
+// Schedule is a schedule as returned by /schedules. The transaction body is base64 encoded.
+type Schedule struct {
+	AdminKey           *Key                `json:"admin_key"`
+	ConsensusTimestamp Timestamp           `json:"consensus_timestamp"`
+	CreatorAccountID   string              `json:"creator_account_id"`
+	Deleted            bool                `json:"deleted"`
+	ExecutedTimestamp  *Timestamp          `json:"executed_timestamp"`
+	ExpirationTime     *Timestamp          `json:"expiration_time"`
+	Memo               string              `json:"memo"`
+	PayerAccountID     string              `json:"payer_account_id"`
+	ScheduleID         string              `json:"schedule_id"`
+	Signatures         []ScheduleSignature `json:"signatures"`
+	TransactionBody    string              `json:"transaction_body"`
+	WaitForExpiry      bool                `json:"wait_for_expiry"`
+}
+
+// GetSchedule returns the schedule with the given ID.
+func (c *Client) GetSchedule(ctx context.Context, scheduleID string) (*Schedule, error) {
+	var schedule Schedule
+	if err := c._Get(ctx, _Path("schedules", scheduleID), nil, &schedule); err != nil {
+		return nil, err
+	}
+
+	return &schedule, nil
+}
+
+// ListSchedules lists the schedules of the network.
+func (c *Client) ListSchedules() *ListRequest[Schedule] {
+	return _NewListRequest[Schedule](c, "schedules", "schedules")
+}
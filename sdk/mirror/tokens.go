@@ -0,0 +1,170 @@
+package mirror
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"strconv"
+)
+
+// TokenSummary is a token as listed by /tokens.
+type TokenSummary struct {
+	TokenID  string `json:"token_id"`
+	Symbol   string `json:"symbol"`
+	Name     string `json:"name"`
+	AdminKey *Key   `json:"admin_key"`
+	Decimals int64  `json:"decimals"`
+	Metadata string `json:"metadata"`
+	Type     string `json:"type"`
+}
+
+// Fraction is the numerator and denominator of a fractional or royalty fee.
+type Fraction struct {
+	Numerator   int64 `json:"numerator"`
+	Denominator int64 `json:"denominator"`
+}
+
+// FixedFee is a custom fee of a fixed amount of hbar or tokens.
+type FixedFee struct {
+	AllCollectorsAreExempt bool    `json:"all_collectors_are_exempt"`
+	Amount                 int64   `json:"amount"`
+	CollectorAccountID     string  `json:"collector_account_id"`
+	DenominatingTokenID    *string `json:"denominating_token_id"`
+}
+
+// FractionalFee is a custom fee of a fraction of the transferred tokens.
+type FractionalFee struct {
+	AllCollectorsAreExempt bool     `json:"all_collectors_are_exempt"`
+	Amount                 Fraction `json:"amount"`
+	CollectorAccountID     string   `json:"collector_account_id"`
+	DenominatingTokenID    *string  `json:"denominating_token_id"`
+	Maximum                *int64   `json:"maximum"`
+	Minimum                int64    `json:"minimum"`
+	NetOfTransfers         bool     `json:"net_of_transfers"`
+}
+
+// RoyaltyFee is a custom fee of a fraction of the value exchanged for an NFT.
+type RoyaltyFee struct {
+	AllCollectorsAreExempt bool     `json:"all_collectors_are_exempt"`
+	Amount                 Fraction `json:"amount"`
+	CollectorAccountID     string   `json:"collector_account_id"`
+	FallbackFee            *struct {
+		Amount              int64   `json:"amount"`
+		DenominatingTokenID *string `json:"denominating_token_id"`
+	} `json:"fallback_fee"`
+}
+
+// CustomFees are the custom fees of a token or topic.
+type CustomFees struct {
+	CreatedTimestamp Timestamp       `json:"created_timestamp"`
+	FixedFees        []FixedFee      `json:"fixed_fees"`
+	FractionalFees   []FractionalFee `json:"fractional_fees"`
+	RoyaltyFees      []RoyaltyFee    `json:"royalty_fees"`
+}
+
+// Token is a token as returned by /tokens/{id}. The supplies and decimals are strings, as returned by the mirror node,
+// since they can exceed the range of int64. ExpiryTimestamp is in nanoseconds.
+type Token struct {
+	AdminKey          *Key       `json:"admin_key"`
+	AutoRenewAccount  *string    `json:"auto_renew_account"`
+	AutoRenewPeriod   *int64     `json:"auto_renew_period"`
+	CreatedTimestamp  Timestamp  `json:"created_timestamp"`
+	CustomFees        CustomFees `json:"custom_fees"`
+	Decimals          string     `json:"decimals"`
+	Deleted           bool       `json:"deleted"`
+	ExpiryTimestamp   *int64     `json:"expiry_timestamp"`
+	FeeScheduleKey    *Key       `json:"fee_schedule_key"`
+	FreezeDefault     bool       `json:"freeze_default"`
+	FreezeKey         *Key       `json:"freeze_key"`
+	InitialSupply     string     `json:"initial_supply"`
+	KycKey            *Key       `json:"kyc_key"`
+	MaxSupply         string     `json:"max_supply"`
+	Memo              string     `json:"memo"`
+	Metadata          string     `json:"metadata"`
+	MetadataKey       *Key       `json:"metadata_key"`
+	ModifiedTimestamp Timestamp  `json:"modified_timestamp"`
+	Name              string     `json:"name"`
+	PauseKey          *Key       `json:"pause_key"`
+	PauseStatus       string     `json:"pause_status"`
+	SupplyKey         *Key       `json:"supply_key"`
+	SupplyType        string     `json:"supply_type"`
+	Symbol            string     `json:"symbol"`
+	TokenID           string     `json:"token_id"`
+	TotalSupply       string     `json:"total_supply"`
+	TreasuryAccountID string     `json:"treasury_account_id"`
+	Type              string     `json:"type"`
+	WipeKey           *Key       `json:"wipe_key"`
+}
+
+// TokenHolder is the balance of a token held by an account, as returned by /tokens/{id}/balances.
+type TokenHolder struct {
+	Account  string `json:"account"`
+	Balance  int64  `json:"balance"`
+	Decimals int64  `json:"decimals"`
+}
+
+// Nft is a non-fungible token.
+type Nft struct {
+	AccountID         string    `json:"account_id"`
+	CreatedTimestamp  Timestamp `json:"created_timestamp"`
+	DelegatingSpender *string   `json:"delegating_spender"`
+	Deleted           bool      `json:"deleted"`
+	// Metadata is base64 encoded
+	Metadata          string    `json:"metadata"`
+	ModifiedTimestamp Timestamp `json:"modified_timestamp"`
+	SerialNumber      int64     `json:"serial_number"`
+	Spender           *string   `json:"spender"`
+	TokenID           string    `json:"token_id"`
+}
+
+// NftTransaction is a transaction which changed the owner of an NFT.
+type NftTransaction struct {
+	ConsensusTimestamp Timestamp `json:"consensus_timestamp"`
+	IsApproval         bool      `json:"is_approval"`
+	Nonce              int64     `json:"nonce"`
+	ReceiverAccountID  *string   `json:"receiver_account_id"`
+	SenderAccountID    *string   `json:"sender_account_id"`
+	TransactionID      string    `json:"transaction_id"`
+	Type               string    `json:"type"`
+}
+
+// GetToken returns the token with the given ID.
+func (c *Client) GetToken(ctx context.Context, tokenID string) (*Token, error) {
+	var token Token
+	if err := c._Get(ctx, _Path("tokens", tokenID), nil, &token); err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// ListTokens lists the tokens of the network.
+func (c *Client) ListTokens() *ListRequest[TokenSummary] {
+	return _NewListRequest[TokenSummary](c, "tokens", "tokens")
+}
+
+// ListTokenBalances lists the accounts holding a token and their balance.
+func (c *Client) ListTokenBalances(tokenID string) *ListRequest[TokenHolder] {
+	return _NewListRequest[TokenHolder](c, _Path("tokens", tokenID, "balances"), "balances")
+}
+
+// ListTokenNfts lists the NFTs of a token.
+func (c *Client) ListTokenNfts(tokenID string) *ListRequest[Nft] {
+	return _NewListRequest[Nft](c, _Path("tokens", tokenID, "nfts"), "nfts")
+}
+
+// GetNft returns a single NFT.
+func (c *Client) GetNft(ctx context.Context, tokenID string, serialNumber int64) (*Nft, error) {
+	var nft Nft
+	if err := c._Get(ctx, _Path("tokens", tokenID, "nfts", strconv.FormatInt(serialNumber, 10)), nil, &nft); err != nil {
+		return nil, err
+	}
+
+	return &nft, nil
+}
+
+// ListNftTransactions lists the transfers of a single NFT.
+func (c *Client) ListNftTransactions(tokenID string, serialNumber int64) *ListRequest[NftTransaction] {
+	path := _Path("tokens", tokenID, "nfts", strconv.FormatInt(serialNumber, 10), "transactions")
+	return _NewListRequest[NftTransaction](c, path, "transactions")
+}
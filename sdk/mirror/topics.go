@@ -0,0 +1,85 @@
+package mirror
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"strconv"
+)
+
+// Topic is a topic as returned by /topics/{id}.
+type Topic struct {
+	AdminKey         *Key           `json:"admin_key"`
+	AutoRenewAccount *string        `json:"auto_renew_account"`
+	AutoRenewPeriod  *int64         `json:"auto_renew_period"`
+	CreatedTimestamp Timestamp      `json:"created_timestamp"`
+	CustomFees       CustomFees     `json:"custom_fees"`
+	Deleted          bool           `json:"deleted"`
+	FeeExemptKeyList []Key          `json:"fee_exempt_key_list"`
+	FeeScheduleKey   *Key           `json:"fee_schedule_key"`
+	Memo             string         `json:"memo"`
+	SubmitKey        *Key           `json:"submit_key"`
+	Timestamp        TimestampRange `json:"timestamp"`
+	TopicID          string         `json:"topic_id"`
+}
+
+// ChunkInfo describes the chunk of a message which was split into several transactions.
+type ChunkInfo struct {
+	InitialTransactionID struct {
+		AccountID             string    `json:"account_id"`
+		Nonce                 int32     `json:"nonce"`
+		Scheduled             bool      `json:"scheduled"`
+		TransactionValidStart Timestamp `json:"transaction_valid_start"`
+	} `json:"initial_transaction_id"`
+	Number int32 `json:"number"`
+	Total  int32 `json:"total"`
+}
+
+// TopicMessage is a message submitted to a topic. The message and running hash are base64 encoded.
+type TopicMessage struct {
+	ChunkInfo          *ChunkInfo `json:"chunk_info"`
+	ConsensusTimestamp Timestamp  `json:"consensus_timestamp"`
+	Message            string     `json:"message"`
+	PayerAccountID     string     `json:"payer_account_id"`
+	RunningHash        string     `json:"running_hash"`
+	RunningHashVersion int32      `json:"running_hash_version"`
+	SequenceNumber     int64      `json:"sequence_number"`
+	TopicID            string     `json:"topic_id"`
+}
+
+// GetTopic returns the topic with the given ID.
+func (c *Client) GetTopic(ctx context.Context, topicID string) (*Topic, error) {
+	var topic Topic
+	if err := c._Get(ctx, _Path("topics", topicID), nil, &topic); err != nil {
+		return nil, err
+	}
+
+	return &topic, nil
+}
+
+// ListTopicMessages lists the messages of a topic. Use Filter("sequencenumber", ...) or Timestamp(...) to select
+// a range of messages.
+func (c *Client) ListTopicMessages(topicID string) *ListRequest[TopicMessage] {
+	return _NewListRequest[TopicMessage](c, _Path("topics", topicID, "messages"), "messages")
+}
+
+// GetTopicMessage returns the message of a topic with the given sequence number.
+func (c *Client) GetTopicMessage(ctx context.Context, topicID string, sequenceNumber int64) (*TopicMessage, error) {
+	var message TopicMessage
+	path := _Path("topics", topicID, "messages", strconv.FormatInt(sequenceNumber, 10))
+	if err := c._Get(ctx, path, nil, &message); err != nil {
+		return nil, err
+	}
+
+	return &message, nil
+}
+
+// GetTopicMessageByTimestamp returns the topic message with the given consensus timestamp.
+func (c *Client) GetTopicMessageByTimestamp(ctx context.Context, timestamp Timestamp) (*TopicMessage, error) {
+	var message TopicMessage
+	if err := c._Get(ctx, _Path("topics", "messages", timestamp.String()), nil, &message); err != nil {
+		return nil, err
+	}
+
+	return &message, nil
+}
@@ -0,0 +1,106 @@
+package mirror
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// Transfer is an hbar transfer of a transaction.
+type Transfer struct {
+	Account    string `json:"account"`
+	Amount     int64  `json:"amount"`
+	IsApproval bool   `json:"is_approval"`
+}
+
+// TokenTransfer is a fungible token transfer of a transaction.
+type TokenTransfer struct {
+	TokenID    string `json:"token_id"`
+	Account    string `json:"account"`
+	Amount     int64  `json:"amount"`
+	IsApproval bool   `json:"is_approval"`
+}
+
+// NftTransfer is an NFT transfer of a transaction.
+type NftTransfer struct {
+	IsApproval        bool    `json:"is_approval"`
+	ReceiverAccountID *string `json:"receiver_account_id"`
+	SenderAccountID   *string `json:"sender_account_id"`
+	SerialNumber      int64   `json:"serial_number"`
+	TokenID           string  `json:"token_id"`
+}
+
+// StakingRewardTransfer is a staking reward paid by a transaction.
+type StakingRewardTransfer struct {
+	Account string `json:"account"`
+	Amount  int64  `json:"amount"`
+}
+
+// AssessedCustomFee is a custom fee charged by a transaction.
+type AssessedCustomFee struct {
+	Amount                   int64    `json:"amount"`
+	CollectorAccountID       string   `json:"collector_account_id"`
+	EffectivePayerAccountIDs []string `json:"effective_payer_account_ids"`
+	TokenID                  *string  `json:"token_id"`
+}
+
+// Transaction is a transaction as returned by /transactions. The bytes, memo and hash are base64 encoded.
+type Transaction struct {
+	AssessedCustomFees       []AssessedCustomFee     `json:"assessed_custom_fees"`
+	Bytes                    *string                 `json:"bytes"`
+	ChargedTxFee             int64                   `json:"charged_tx_fee"`
+	ConsensusTimestamp       Timestamp               `json:"consensus_timestamp"`
+	EntityID                 *string                 `json:"entity_id"`
+	MaxFee                   string                  `json:"max_fee"`
+	MemoBase64               string                  `json:"memo_base64"`
+	Name                     string                  `json:"name"`
+	NftTransfers             []NftTransfer           `json:"nft_transfers"`
+	Node                     *string                 `json:"node"`
+	Nonce                    int32                   `json:"nonce"`
+	ParentConsensusTimestamp *Timestamp              `json:"parent_consensus_timestamp"`
+	Result                   string                  `json:"result"`
+	Scheduled                bool                    `json:"scheduled"`
+	StakingRewardTransfers   []StakingRewardTransfer `json:"staking_reward_transfers"`
+	TokenTransfers           []TokenTransfer         `json:"token_transfers"`
+	TransactionHash          string                  `json:"transaction_hash"`
+	TransactionID            string                  `json:"transaction_id"`
+	Transfers                []Transfer              `json:"transfers"`
+	ValidDurationSeconds     string                  `json:"valid_duration_seconds"`
+	ValidStartTimestamp      Timestamp               `json:"valid_start_timestamp"`
+}
+
+// ListTransactions lists the transactions of the network. Use Filter("account.id", ...) to select the transactions
+// of an account and Param("transactiontype", ...) to select a type.
+func (c *Client) ListTransactions() *ListRequest[Transaction] {
+	return _NewListRequest[Transaction](c, "transactions", "transactions")
+}
+
+// TransactionFilter selects among the transactions sharing a transaction ID. Nonce selects the transaction itself
+// (0) or one of its children, Scheduled selects the scheduled transaction of a schedule or the schedule creation.
+type TransactionFilter struct {
+	Nonce     *int32
+	Scheduled *bool
+}
+
+// GetTransaction returns the transactions with the given transaction ID, eg. "0.0.2-1700000000-000000001": the
+// transaction itself, its child transactions and its scheduled transaction, unless filtered out.
+func (c *Client) GetTransaction(ctx context.Context, transactionID string, filter TransactionFilter) ([]Transaction, error) {
+	query := url.Values{}
+	if filter.Nonce != nil {
+		query.Set("nonce", strconv.FormatInt(int64(*filter.Nonce), 10))
+	}
+	if filter.Scheduled != nil {
+		query.Set("scheduled", strconv.FormatBool(*filter.Scheduled))
+	}
+
+	var response struct {
+		Transactions []Transaction `json:"transactions"`
+	}
+	if err := c._Get(ctx, _Path("transactions", transactionID), query, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Transactions, nil
+}
@@ -4,6 +4,7 @@ package hiero
 
 import (
 	"math/rand"
+	"sort"
 )
 
 type _MirrorNetwork struct {
@@ -42,10 +43,71 @@ func (network *_MirrorNetwork) _SetTransportSecurity(transportSecurity bool) *_M
 	return network
 }
 
+// _GetNextMirrorNode returns a random healthy mirror node, or the node closest to being readmitted when none of
+// them are healthy.
 func (network *_MirrorNetwork) _GetNextMirrorNode() *_MirrorNode {
-	node := network._ManagedNetwork.healthyNodes[rand.Intn(len(network.healthyNodes))] // nolint
-	if node, ok := node.(*_MirrorNode); ok {
-		return node
+	nodes := network._GetMirrorNodes()
+	if len(nodes) == 0 {
+		return &_MirrorNode{}
 	}
-	return &_MirrorNode{}
+
+	return nodes[0]
+}
+
+// _GetMirrorNodes returns the mirror nodes in the order they should be tried: the healthy nodes in random order,
+// followed by the unhealthy nodes from the earliest to the latest readmit time.
+func (network *_MirrorNetwork) _GetMirrorNodes() []*_MirrorNode {
+	network._ReadmitNodes()
+
+	network.healthyNodesMutex.RLock()
+	defer network.healthyNodesMutex.RUnlock()
+
+	healthy := make([]*_MirrorNode, 0, len(network.healthyNodes))
+	unhealthy := make([]*_MirrorNode, 0)
+
+outer:
+	for _, node := range network.nodes {
+		mirrorNode, ok := node.(*_MirrorNode)
+		if !ok {
+			continue
+		}
+
+		for _, healthyNode := range network.healthyNodes {
+			if node == healthyNode {
+				healthy = append(healthy, mirrorNode)
+				continue outer
+			}
+		}
+
+		unhealthy = append(unhealthy, mirrorNode)
+	}
+
+	rand.Shuffle(len(healthy), func(i, j int) { // nolint
+		healthy[i], healthy[j] = healthy[j], healthy[i]
+	})
+	sort.SliceStable(unhealthy, func(i, j int) bool {
+		first, second := unhealthy[i]._GetReadmitTime(), unhealthy[j]._GetReadmitTime()
+		return first == nil || (second != nil && first.Before(*second))
+	})
+
+	return append(healthy, unhealthy...)
+}
+
+// _IncreaseBackoff marks a mirror node which failed a request as unhealthy until its backoff has passed.
+func (network *_MirrorNetwork) _IncreaseBackoff(node *_MirrorNode) {
+	network.healthyNodesMutex.Lock()
+	defer network.healthyNodesMutex.Unlock()
+	node._IncreaseBackoff()
+
+	for i, healthyNode := range network.healthyNodes {
+		if healthyNode == _IManagedNode(node) {
+			network.healthyNodes = append(network.healthyNodes[:i], network.healthyNodes[i+1:]...)
+			break
+		}
+	}
+}
+
+// _DecreaseBackoff records a successful request to a mirror node.
+func (network *_MirrorNetwork) _DecreaseBackoff(node *_MirrorNode) {
+	node._DecreaseBackoff()
 }
@@ -0,0 +1,88 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/sdk/mirror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitMirrorNetworkOrdersHealthyNodesFirst(t *testing.T) {
+	t.Parallel()
+
+	network := _NewMirrorNetwork()
+	require.NoError(t, network._SetNetwork([]string{"mirror1:443", "mirror2:443", "mirror3:443"}))
+	require.Len(t, network._GetMirrorNodes(), 3)
+
+	first := network._GetMirrorNodes()[0]
+	network._IncreaseBackoff(first)
+	second := network._GetMirrorNodes()[0]
+	require.NotSame(t, first, second)
+	network._IncreaseBackoff(second)
+	network._IncreaseBackoff(second)
+
+	nodes := network._GetMirrorNodes()
+	require.Len(t, nodes, 3)
+	assert.NotSame(t, first, nodes[0])
+	assert.NotSame(t, second, nodes[0])
+	// Unhealthy nodes follow, the one readmitted first before the other
+	assert.Same(t, first, nodes[1])
+	assert.Same(t, second, nodes[2])
+
+	// Every node failed, the one closest to being readmitted is used
+	network._IncreaseBackoff(nodes[0])
+	network._IncreaseBackoff(nodes[0])
+	network._IncreaseBackoff(nodes[0])
+	assert.Same(t, first, network._GetNextMirrorNode())
+}
+
+func TestUnitClientCallMirrorRestFailsOver(t *testing.T) {
+	t.Parallel()
+
+	client := ClientForNetwork(map[string]AccountID{})
+	client.SetMirrorNetwork([]string{"mirror1.example.com:443", "mirror2.example.com:443"})
+
+	var baseURLs []string
+	err := client._CallMirrorRest("5551", func(mirrorClient *mirror.Client) error {
+		baseURLs = append(baseURLs, mirrorClient.GetBaseURL())
+		if len(baseURLs) == 1 {
+			return &mirror.Error{StatusCode: http.StatusServiceUnavailable}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, baseURLs, 2)
+	assert.NotEqual(t, baseURLs[0], baseURLs[1])
+	failed := baseURLs[0]
+
+	// The failed node is tried last until it is readmitted, and client errors don't fail over
+	baseURLs = nil
+	err = client._CallMirrorRest("5551", func(mirrorClient *mirror.Client) error {
+		baseURLs = append(baseURLs, mirrorClient.GetBaseURL())
+		return &mirror.Error{StatusCode: http.StatusNotFound}
+	})
+	require.Error(t, err)
+	assert.True(t, mirror.IsNotFound(err))
+	require.Len(t, baseURLs, 1)
+	assert.NotEqual(t, failed, baseURLs[0])
+
+	// Every node failing returns the last error
+	baseURLs = nil
+	err = client._CallMirrorRest("5551", func(mirrorClient *mirror.Client) error {
+		baseURLs = append(baseURLs, mirrorClient.GetBaseURL())
+		return &mirror.Error{StatusCode: http.StatusTooManyRequests}
+	})
+	require.Error(t, err)
+	assert.Len(t, baseURLs, 2)
+
+	client.SetMirrorNetwork([]string{})
+	err = client._CallMirrorRest("5551", func(mirrorClient *mirror.Client) error { return nil })
+	require.Error(t, err)
+}
@@ -0,0 +1,126 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/sdk/mirror"
+)
+
+// mirrorNftQuery holds the filters shared by AccountNftsQuery and TokenNftsQuery.
+type mirrorNftQuery struct {
+	tokenID    *TokenID
+	accountID  *AccountID
+	spenderID  *AccountID
+	serialFrom *int64
+	serialTo   *int64
+	order      mirror.Order
+	pageSize   int
+}
+
+func (query *mirrorNftQuery) _ApplyFilters(request *mirror.ListRequest[mirror.Nft]) {
+	if query.order != "" {
+		request.Order(query.order)
+	}
+	if query.pageSize > 0 {
+		request.Limit(query.pageSize)
+	}
+	if query.serialFrom != nil {
+		request.Filter("serialnumber", mirror.OperatorGte, strconv.FormatInt(*query.serialFrom, 10))
+	}
+	if query.serialTo != nil {
+		request.Filter("serialnumber", mirror.OperatorLte, strconv.FormatInt(*query.serialTo, 10))
+	}
+}
+
+// _Iterate maps the NFTs of the request built by newRequest to TokenNftInfo, skipping the ones which aren't approved
+// to the spender when the endpoint can't filter on it. Each page is fetched through the mirror network, so a failing
+// mirror node is left for the next one in the middle of the list.
+func (query *mirrorNftQuery) _Iterate(client *Client, newRequest func(mirrorClient *mirror.Client) *mirror.ListRequest[mirror.Nft], filterSpender bool) mirror.Iterator[TokenNftInfo] {
+	return func(yield func(TokenNftInfo, error) bool) {
+		var link *string
+		for {
+			var nfts []mirror.Nft
+			var next *string
+			err := client._CallMirrorRest("5551", func(mirrorClient *mirror.Client) (err error) {
+				request := newRequest(mirrorClient)
+				if link == nil {
+					nfts, next, err = request.Page(context.Background())
+				} else {
+					nfts, next, err = request.PageAt(context.Background(), *link)
+				}
+				return err
+			})
+			if err != nil {
+				yield(TokenNftInfo{}, err)
+				return
+			}
+
+			for _, nft := range nfts {
+				info, err := _TokenNftInfoFromMirror(nft, client.GetLedgerID())
+				if err != nil {
+					yield(TokenNftInfo{}, err)
+					return
+				}
+
+				if filterSpender && query.spenderID != nil && info.SpenderID.String() != query.spenderID.String() {
+					continue
+				}
+
+				if !yield(info, nil) {
+					return
+				}
+			}
+
+			if next == nil {
+				return
+			}
+			link = next
+		}
+	}
+}
+
+func _TokenNftInfoFromMirror(nft mirror.Nft, ledgerID *LedgerID) (TokenNftInfo, error) {
+	tokenID, err := TokenIDFromString(nft.TokenID)
+	if err != nil {
+		return TokenNftInfo{}, err
+	}
+
+	accountID := AccountID{}
+	if nft.AccountID != "" {
+		if accountID, err = AccountIDFromString(nft.AccountID); err != nil {
+			return TokenNftInfo{}, err
+		}
+	}
+
+	spenderID, err := _AccountIDFromMirrorPointer(nft.Spender)
+	if err != nil {
+		return TokenNftInfo{}, err
+	}
+
+	creationTime, err := nft.CreatedTimestamp.Time()
+	if err != nil {
+		return TokenNftInfo{}, err
+	}
+
+	metadata, err := base64.StdEncoding.DecodeString(nft.Metadata)
+	if err != nil {
+		return TokenNftInfo{}, err
+	}
+
+	info := TokenNftInfo{
+		NftID:        NftID{TokenID: tokenID, SerialNumber: nft.SerialNumber},
+		AccountID:    accountID,
+		CreationTime: creationTime,
+		Metadata:     metadata,
+		SpenderID:    spenderID,
+	}
+	if ledgerID != nil {
+		info.LedgerID = *ledgerID
+	}
+
+	return info, nil
+}
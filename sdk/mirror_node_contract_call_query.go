@@ -44,6 +44,17 @@ func (mirrorNodeContractCallQuery *MirrorNodeContractCallQuery) SetFunction(name
 	return mirrorNodeContractCallQuery
 }
 
+// SetFunctionFromABI sets the calldata from a method of an ABI and its arguments.
+func (mirrorNodeContractCallQuery *MirrorNodeContractCallQuery) SetFunctionFromABI(method *Method, args ...interface{}) (*MirrorNodeContractCallQuery, error) {
+	data, err := _EncodeCall(method, args)
+	if err != nil {
+		return mirrorNodeContractCallQuery, err
+	}
+
+	mirrorNodeContractCallQuery.callData = data
+	return mirrorNodeContractCallQuery, nil
+}
+
 // SetFunction sets the function parameters as their raw bytes.
 func (mirrorNodeContractCallQuery *MirrorNodeContractCallQuery) SetFunctionParameters(byteArray []byte) *MirrorNodeContractCallQuery {
 	mirrorNodeContractCallQuery.callData = byteArray
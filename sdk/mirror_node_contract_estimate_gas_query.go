@@ -44,6 +44,17 @@ func (mirrorNodeEstimateGasQuery *MirrorNodeContractEstimateGasQuery) SetFunctio
 	return mirrorNodeEstimateGasQuery
 }
 
+// SetFunctionFromABI sets the calldata from a method of an ABI and its arguments.
+func (mirrorNodeEstimateGasQuery *MirrorNodeContractEstimateGasQuery) SetFunctionFromABI(method *Method, args ...interface{}) (*MirrorNodeContractEstimateGasQuery, error) {
+	data, err := _EncodeCall(method, args)
+	if err != nil {
+		return mirrorNodeEstimateGasQuery, err
+	}
+
+	mirrorNodeEstimateGasQuery.callData = data
+	return mirrorNodeEstimateGasQuery, nil
+}
+
 // SetFunction sets the function parameters as their raw bytes.
 func (mirrorNodeEstimateGasQuery *MirrorNodeContractEstimateGasQuery) SetFunctionParameters(byteArray []byte) *MirrorNodeContractEstimateGasQuery {
 	mirrorNodeEstimateGasQuery.callData = byteArray
@@ -1,15 +1,13 @@
 package hiero
 
 import (
-	"bytes"
+	"context"
 	"encoding/hex"
-	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 	"strconv"
 	"strings"
 
+	"github.com/hiero-ledger/hiero-sdk-go/v2/sdk/mirror"
 	"github.com/pkg/errors"
 )
 
@@ -128,20 +126,11 @@ func (mirrorNodeContractQuery *mirrorNodeContractQuery) estimateGas(client *Clie
 		return 0, err
 	}
 
-	jsonPayload, err := mirrorNodeContractQuery.createJSONPayload(true, "latest")
+	hexString, err := mirrorNodeContractQuery.performContractCallToMirrorNode(client, mirrorNodeContractQuery.createCallRequest(true, "latest"))
 	if err != nil {
 		return 0, err
 	}
 
-	result, err := mirrorNodeContractQuery.performContractCallToMirrorNode(client, jsonPayload)
-	if err != nil {
-		return 0, err
-	}
-
-	hexString, ok := result["result"].(string)
-	if !ok {
-		return 0, fmt.Errorf("result is not a string")
-	}
 	hexString = strings.TrimPrefix(hexString, "0x")
 	gas, err := strconv.ParseUint(hexString, 16, 64)
 	if err != nil {
@@ -163,21 +152,7 @@ func (mirrorNodeContractQuery *mirrorNodeContractQuery) call(client *Client) (st
 	} else {
 		blockNumber = fmt.Sprintf("%d", *mirrorNodeContractQuery.blockNumber)
 	}
-	jsonPayload, err := mirrorNodeContractQuery.createJSONPayload(false, blockNumber)
-	if err != nil {
-		return "", err
-	}
-
-	result, err := mirrorNodeContractQuery.performContractCallToMirrorNode(client, jsonPayload)
-	if err != nil {
-		return "", err
-	}
-
-	hexString, ok := result["result"].(string)
-	if !ok {
-		return "", fmt.Errorf("result is not a string")
-	}
-	return hexString, nil
+	return mirrorNodeContractQuery.performContractCallToMirrorNode(client, mirrorNodeContractQuery.createCallRequest(false, blockNumber))
 }
 
 // Retrieve and set the evm addresses if necessary
@@ -199,73 +174,28 @@ func (mirrorNodeContractQuery *mirrorNodeContractQuery) fillEvmAddresses() error
 	return nil
 }
 
-func (mirrorNodeContractQuery *mirrorNodeContractQuery) performContractCallToMirrorNode(client *Client, jsonPayload string) (map[string]any, error) {
-	if client.mirrorNetwork == nil || len(client.GetMirrorNetwork()) == 0 {
-		return nil, errors.New("mirror node is not set")
-	}
-	mirrorUrl := client.GetMirrorNetwork()[0]
-	index := strings.Index(mirrorUrl, ":")
-	if index == -1 {
-		return nil, errors.New("invalid mirrorUrl format")
-	}
-	mirrorUrl = mirrorUrl[:index]
-
-	var url string
-	protocol := "https"
-	port := ""
-
-	if client.GetLedgerID().String() == "" {
-		protocol = "http"
-		port = ":8545"
-	}
-	url = fmt.Sprintf("%s://%s%s/api/v1/contracts/call", protocol, mirrorUrl, port)
-
-	resp, err := http.Post(url, "application/json", bytes.NewBuffer([]byte(jsonPayload))) // #nosec
+func (mirrorNodeContractQuery *mirrorNodeContractQuery) performContractCallToMirrorNode(client *Client, request mirror.ContractCallRequest) (string, error) {
+	var response *mirror.ContractCallResponse
+	err := client._CallMirrorRest("8545", func(mirrorClient *mirror.Client) (err error) {
+		response, err = mirrorClient.CallContract(context.Background(), request)
+		return err
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to send request: %w", err)
-	}
-
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("received non-200 response from Mirror Node: %d, details: %s", resp.StatusCode, body)
+		return "", fmt.Errorf("failed to call the contract on the Mirror Node: %w", err)
 	}
 
-	var result map[string]any
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, err
-	}
-	return result, nil
+	return response.Result, nil
 }
 
-func (mirrorNodeContractQuery *mirrorNodeContractQuery) createJSONPayload(estimate bool, blockNumber string) (string, error) {
-	hexData := hex.EncodeToString(mirrorNodeContractQuery.callData)
-
-	payload := map[string]any{
-		"data":        hexData,
-		"to":          mirrorNodeContractQuery.contractEvmAddress,
-		"estimate":    estimate,
-		"blockNumber": blockNumber,
-	}
-
-	// Conditionally add fields if they are set to non-default values
-	if mirrorNodeContractQuery.senderEvmAddress != nil {
-		payload["from"] = mirrorNodeContractQuery.senderEvmAddress
-	}
-	if mirrorNodeContractQuery.gasLimit != nil {
-		payload["gas"] = mirrorNodeContractQuery.gasLimit
-	}
-	if mirrorNodeContractQuery.gasPrice != nil {
-		payload["gasPrice"] = mirrorNodeContractQuery.gasPrice
-	}
-	if mirrorNodeContractQuery.value != nil {
-		payload["value"] = mirrorNodeContractQuery.value
-	}
-
-	jsonBytes, err := json.Marshal(payload)
-	if err != nil {
-		return "", fmt.Errorf("failed to marshal JSON: %w", err)
+func (mirrorNodeContractQuery *mirrorNodeContractQuery) createCallRequest(estimate bool, blockNumber string) mirror.ContractCallRequest {
+	return mirror.ContractCallRequest{
+		Data:        hex.EncodeToString(mirrorNodeContractQuery.callData),
+		To:          mirrorNodeContractQuery.contractEvmAddress,
+		Estimate:    estimate,
+		BlockNumber: blockNumber,
+		From:        mirrorNodeContractQuery.senderEvmAddress,
+		Gas:         mirrorNodeContractQuery.gasLimit,
+		GasPrice:    mirrorNodeContractQuery.gasPrice,
+		Value:       mirrorNodeContractQuery.value,
 	}
-
-	return string(jsonBytes), nil
 }
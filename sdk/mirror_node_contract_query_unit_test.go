@@ -6,6 +6,8 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"encoding/json"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -134,7 +136,7 @@ func TestMirrorNodeContractQueryEstimateGasWithMissingContractIDOrEvmAddressThro
 	require.Error(t, err2)
 }
 
-func TestMirrorNodeContractQueryCreateJSONPayloadAllFieldsSet(t *testing.T) {
+func TestMirrorNodeContractQueryCreateCallRequestAllFieldsSet(t *testing.T) {
 	query := &mirrorNodeContractQuery{
 		callData:           []byte("testData"),
 		senderEvmAddress:   stringPtr("0x1234567890abcdef1234567890abcdef12345678"),
@@ -145,27 +147,27 @@ func TestMirrorNodeContractQueryCreateJSONPayloadAllFieldsSet(t *testing.T) {
 		blockNumber:        int64Ptr(123456),
 	}
 
-	jsonPayload, err := query.createJSONPayload(true, "latest")
+	jsonPayload, err := json.Marshal(query.createCallRequest(true, "latest"))
 	assert.NoError(t, err)
 
 	expectedJson := `{"data":"7465737444617461","to":"0xabcdefabcdefabcdefabcdefabcdefabcdef","estimate":true,"blockNumber":"latest","from":"0x1234567890abcdef1234567890abcdef12345678","gas":50000,"gasPrice":2000,"value":1000}`
-	assert.JSONEq(t, expectedJson, jsonPayload)
+	assert.JSONEq(t, expectedJson, string(jsonPayload))
 }
 
-func TestMirrorNodeContractQueryCreateJSONPayloadOnlyRequiredFieldsSet(t *testing.T) {
+func TestMirrorNodeContractQueryCreateCallRequestOnlyRequiredFieldsSet(t *testing.T) {
 	query := &mirrorNodeContractQuery{
 		callData:           []byte("testData"),
 		contractEvmAddress: stringPtr("0xabcdefabcdefabcdefabcdefabcdefabcdef"),
 	}
 
-	jsonPayload, err := query.createJSONPayload(true, "latest")
+	jsonPayload, err := json.Marshal(query.createCallRequest(true, "latest"))
 	assert.NoError(t, err)
 
 	expectedJson := `{"data":"7465737444617461","to":"0xabcdefabcdefabcdefabcdefabcdefabcdef","estimate":true,"blockNumber":"latest"}`
-	assert.JSONEq(t, expectedJson, jsonPayload)
+	assert.JSONEq(t, expectedJson, string(jsonPayload))
 }
 
-func TestMirrorNodeContractQueryCreateJSONPayloadSomeOptionalFieldsSet(t *testing.T) {
+func TestMirrorNodeContractQueryCreateCallRequestSomeOptionalFieldsSet(t *testing.T) {
 	query := &mirrorNodeContractQuery{
 		callData:           []byte("testData"),
 		senderEvmAddress:   stringPtr("0x1234567890abcdef1234567890abcdef12345678"),
@@ -174,24 +176,24 @@ func TestMirrorNodeContractQueryCreateJSONPayloadSomeOptionalFieldsSet(t *testin
 		value:              int64Ptr(1000),
 	}
 
-	jsonPayload, err := query.createJSONPayload(false, "latest")
+	jsonPayload, err := json.Marshal(query.createCallRequest(false, "latest"))
 	assert.NoError(t, err)
 
 	expectedJson := `{"data":"7465737444617461","to":"0xabcdefabcdefabcdefabcdefabcdefabcdef","estimate":false,"blockNumber":"latest","from":"0x1234567890abcdef1234567890abcdef12345678","gas":50000,"value":1000}`
-	assert.JSONEq(t, expectedJson, jsonPayload)
+	assert.JSONEq(t, expectedJson, string(jsonPayload))
 }
 
-func TestMirrorNodeContractQueryCreateJSONPayloadAllOptionalFieldsDefault(t *testing.T) {
+func TestMirrorNodeContractQueryCreateCallRequestAllOptionalFieldsDefault(t *testing.T) {
 	query := &mirrorNodeContractQuery{
 		callData:           []byte("testData"),
 		contractEvmAddress: stringPtr("0xabcdefabcdefabcdefabcdefabcdefabcdef"),
 	}
 
-	jsonPayload, err := query.createJSONPayload(false, "latest")
+	jsonPayload, err := json.Marshal(query.createCallRequest(false, "latest"))
 	assert.NoError(t, err)
 
 	expectedJson := `{"data":"7465737444617461","to":"0xabcdefabcdefabcdefabcdefabcdefabcdef","estimate":false,"blockNumber":"latest"}`
-	assert.JSONEq(t, expectedJson, jsonPayload)
+	assert.JSONEq(t, expectedJson, string(jsonPayload))
 }
 
 // Helper functions
@@ -202,3 +204,28 @@ func stringPtr(s string) *string {
 func int64Ptr(i int64) *int64 {
 	return &i
 }
+
+func TestMirrorNodeContractQuerySetFunctionFromABI(t *testing.T) {
+	abi, err := NewABI(abiCallTestABI)
+	require.NoError(t, err)
+	method := abi.GetMethod("setHoldings")
+	holdings := []abiCallTestHolding{{Owner: Address{1}, Amounts: []*big.Int{big.NewInt(1)}}}
+
+	expected, err := method.Encode([]interface{}{holdings, "label"})
+	require.NoError(t, err)
+
+	query1, err := NewMirrorNodeContractEstimateGasQuery().SetFunctionFromABI(method, holdings, "label")
+	require.NoError(t, err)
+	assert.Equal(t, expected, query1.GetCallData())
+
+	query2, err := NewMirrorNodeContractCallQuery().SetFunctionFromABI(method, holdings, "label")
+	require.NoError(t, err)
+	assert.Equal(t, expected, query2.GetCallData())
+
+	query3, err := NewContractCallQuery().SetFunctionFromABI(method, holdings, "label")
+	require.NoError(t, err)
+	assert.Equal(t, expected, query3.GetFunctionParameters())
+
+	_, err = NewMirrorNodeContractCallQuery().SetFunctionFromABI(method)
+	require.Error(t, err)
+}
@@ -0,0 +1,359 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/hiero-ledger/hiero-sdk-go/v2/sdk/mirror"
+	"github.com/pkg/errors"
+)
+
+// MirrorTransactionRecordQuery gets the record of a transaction from the mirror node. Unlike TransactionRecordQuery,
+// which only works for a few minutes after consensus while the nodes keep the record, it works for any transaction
+// the mirror node has imported.
+//
+// The record is built from the REST API of the mirror node, which doesn't return every field of a record:
+// the exchange rates, the topic running hash, the contract call result and the PRNG output are left unset.
+type MirrorTransactionRecordQuery struct {
+	transactionID   *TransactionID
+	includeChildren bool
+}
+
+// NewMirrorTransactionRecordQuery creates a MirrorTransactionRecordQuery which gets the record of a transaction
+// from the mirror node.
+func NewMirrorTransactionRecordQuery() *MirrorTransactionRecordQuery {
+	return &MirrorTransactionRecordQuery{}
+}
+
+// SetTransactionID sets the ID of the transaction. The nonce and scheduled flag of the ID select a child
+// or scheduled transaction.
+func (query *MirrorTransactionRecordQuery) SetTransactionID(transactionID TransactionID) *MirrorTransactionRecordQuery {
+	query.transactionID = &transactionID
+	return query
+}
+
+// GetTransactionID returns the ID of the transaction.
+func (query *MirrorTransactionRecordQuery) GetTransactionID() TransactionID {
+	if query.transactionID == nil {
+		return TransactionID{}
+	}
+
+	return *query.transactionID
+}
+
+// SetIncludeChildren sets whether the records of the child transactions are returned in TransactionRecord.Children,
+// and their receipts in TransactionReceipt.Children.
+func (query *MirrorTransactionRecordQuery) SetIncludeChildren(includeChildren bool) *MirrorTransactionRecordQuery {
+	query.includeChildren = includeChildren
+	return query
+}
+
+// GetIncludeChildren returns whether the records of the child transactions are returned.
+func (query *MirrorTransactionRecordQuery) GetIncludeChildren() bool {
+	return query.includeChildren
+}
+
+// Execute gets the record from the mirror node. Like TransactionRecordQuery, it returns the record together with an
+// ErrHederaReceiptStatus if the transaction failed, and an ErrHederaPreCheckStatus with RECORD_NOT_FOUND if the
+// mirror node doesn't know the transaction.
+func (query *MirrorTransactionRecordQuery) Execute(client *Client) (TransactionRecord, error) {
+	if query.transactionID == nil || query.transactionID.AccountID == nil || query.transactionID.ValidStart == nil {
+		return TransactionRecord{}, errors.New("transaction ID is required")
+	}
+
+	transactionID := *query.transactionID
+	scheduled := transactionID.GetScheduled()
+	nonce := int32(0)
+	if transactionID.Nonce != nil {
+		nonce = *transactionID.Nonce
+	}
+
+	// The children are only fetched along with the parent transaction
+	filter := mirror.TransactionFilter{Scheduled: &scheduled}
+	if nonce != 0 || !query.includeChildren {
+		filter.Nonce = &nonce
+	}
+
+	var transactions []mirror.Transaction
+	err := client._CallMirrorRest("5551", func(mirrorClient *mirror.Client) (err error) {
+		transactions, err = mirrorClient.GetTransaction(context.Background(), _TransactionIDToMirrorString(transactionID), filter)
+		return err
+	})
+	if mirror.IsNotFound(err) || err == nil && len(transactions) == 0 {
+		return TransactionRecord{}, ErrHederaPreCheckStatus{TxID: transactionID, Status: StatusRecordNotFound}
+	}
+	if err != nil {
+		return TransactionRecord{}, err
+	}
+
+	var record *TransactionRecord
+	children := make([]TransactionRecord, 0)
+	for _, transaction := range transactions {
+		current, err := _TransactionRecordFromMirror(transaction)
+		if err != nil {
+			return TransactionRecord{}, err
+		}
+
+		if record == nil && transaction.Nonce == nonce {
+			record = &current
+		} else if transaction.Nonce != 0 {
+			children = append(children, current)
+		}
+	}
+	if record == nil {
+		return TransactionRecord{}, ErrHederaPreCheckStatus{TxID: transactionID, Status: StatusRecordNotFound}
+	}
+
+	if query.includeChildren {
+		record.Children = children
+		record.Receipt.Children = make([]TransactionReceipt, 0, len(children))
+		for _, child := range children {
+			record.Receipt.Children = append(record.Receipt.Children, child.Receipt)
+		}
+	}
+
+	if record.Receipt.Status != StatusSuccess {
+		return *record, ErrHederaReceiptStatus{TxID: transactionID, Status: record.Receipt.Status, Receipt: record.Receipt}
+	}
+
+	return *record, nil
+}
+
+// _TransactionIDToMirrorString returns the transaction ID in the "0.0.2-1700000000-000000001" form of the mirror node.
+func _TransactionIDToMirrorString(transactionID TransactionID) string {
+	validStart := _TimeToProtobuf(*transactionID.ValidStart)
+	return fmt.Sprintf("%s-%d-%09d", transactionID.AccountID.String(), validStart.Seconds, validStart.Nanos)
+}
+
+// _TransactionIDFromMirrorString parses a transaction ID in the form of the mirror node.
+func _TransactionIDFromMirrorString(transactionID string, scheduled bool, nonce int32) (TransactionID, error) {
+	parts := strings.Split(transactionID, "-")
+	if len(parts) != 3 {
+		return TransactionID{}, fmt.Errorf("invalid mirror node transaction ID %q", transactionID)
+	}
+
+	id, err := TransactionIdFromString(fmt.Sprintf("%s@%s.%s", parts[0], parts[1], parts[2]))
+	if err != nil {
+		return TransactionID{}, err
+	}
+
+	id = id.SetScheduled(scheduled)
+	if nonce != 0 {
+		id.Nonce = &nonce
+	}
+
+	return id, nil
+}
+
+func _TransactionRecordFromMirror(transaction mirror.Transaction) (TransactionRecord, error) {
+	transactionID, err := _TransactionIDFromMirrorString(transaction.TransactionID, transaction.Scheduled, transaction.Nonce)
+	if err != nil {
+		return TransactionRecord{}, err
+	}
+
+	consensusTimestamp, err := transaction.ConsensusTimestamp.Time()
+	if err != nil {
+		return TransactionRecord{}, err
+	}
+
+	var parentConsensusTimestamp time.Time
+	if transaction.ParentConsensusTimestamp != nil {
+		if parentConsensusTimestamp, err = transaction.ParentConsensusTimestamp.Time(); err != nil {
+			return TransactionRecord{}, err
+		}
+	}
+
+	hash, err := base64.StdEncoding.DecodeString(transaction.TransactionHash)
+	if err != nil {
+		return TransactionRecord{}, err
+	}
+
+	memo, err := base64.StdEncoding.DecodeString(transaction.MemoBase64)
+	if err != nil {
+		return TransactionRecord{}, err
+	}
+
+	receipt, err := _TransactionReceiptFromMirror(transaction, transactionID)
+	if err != nil {
+		return TransactionRecord{}, err
+	}
+
+	record := TransactionRecord{
+		Receipt:                  receipt,
+		TransactionHash:          hash,
+		ConsensusTimestamp:       consensusTimestamp,
+		TransactionID:            transactionID,
+		TransactionMemo:          string(memo),
+		TransactionFee:           HbarFromTinybar(transaction.ChargedTxFee),
+		Transfers:                make([]Transfer, 0, len(transaction.Transfers)),
+		TokenTransfers:           make(map[TokenID][]TokenTransfer),
+		NftTransfers:             make(map[TokenID][]_TokenNftTransfer),
+		AssessedCustomFees:       make([]AssessedCustomFee, 0, len(transaction.AssessedCustomFees)),
+		ParentConsensusTimestamp: parentConsensusTimestamp,
+		PaidStakingRewards:       make(map[AccountID]Hbar),
+	}
+
+	for _, transfer := range transaction.Transfers {
+		accountID, err := AccountIDFromString(transfer.Account)
+		if err != nil {
+			return TransactionRecord{}, err
+		}
+		record.Transfers = append(record.Transfers, Transfer{
+			AccountID:  accountID,
+			Amount:     HbarFromTinybar(transfer.Amount),
+			IsApproved: transfer.IsApproval,
+		})
+	}
+
+	for _, transfer := range transaction.TokenTransfers {
+		tokenID, err := TokenIDFromString(transfer.TokenID)
+		if err != nil {
+			return TransactionRecord{}, err
+		}
+		accountID, err := AccountIDFromString(transfer.Account)
+		if err != nil {
+			return TransactionRecord{}, err
+		}
+		record.TokenTransfers[tokenID] = append(record.TokenTransfers[tokenID], TokenTransfer{
+			AccountID:  accountID,
+			Amount:     transfer.Amount,
+			IsApproved: transfer.IsApproval,
+		})
+	}
+
+	for _, transfer := range transaction.NftTransfers {
+		tokenID, err := TokenIDFromString(transfer.TokenID)
+		if err != nil {
+			return TransactionRecord{}, err
+		}
+		sender, err := _AccountIDFromMirrorPointer(transfer.SenderAccountID)
+		if err != nil {
+			return TransactionRecord{}, err
+		}
+		receiver, err := _AccountIDFromMirrorPointer(transfer.ReceiverAccountID)
+		if err != nil {
+			return TransactionRecord{}, err
+		}
+		record.NftTransfers[tokenID] = append(record.NftTransfers[tokenID], _TokenNftTransfer{
+			SenderAccountID:   sender,
+			ReceiverAccountID: receiver,
+			SerialNumber:      transfer.SerialNumber,
+			IsApproved:        transfer.IsApproval,
+		})
+	}
+
+	for _, fee := range transaction.AssessedCustomFees {
+		assessedFee := AssessedCustomFee{
+			Amount:          fee.Amount,
+			PayerAccountIDs: make([]*AccountID, 0, len(fee.EffectivePayerAccountIDs)),
+		}
+		if fee.TokenID != nil {
+			tokenID, err := TokenIDFromString(*fee.TokenID)
+			if err != nil {
+				return TransactionRecord{}, err
+			}
+			assessedFee.TokenID = &tokenID
+		}
+		if fee.CollectorAccountID != "" {
+			collector, err := AccountIDFromString(fee.CollectorAccountID)
+			if err != nil {
+				return TransactionRecord{}, err
+			}
+			assessedFee.FeeCollectorAccountId = &collector
+		}
+		for _, payer := range fee.EffectivePayerAccountIDs {
+			payerID, err := AccountIDFromString(payer)
+			if err != nil {
+				return TransactionRecord{}, err
+			}
+			assessedFee.PayerAccountIDs = append(assessedFee.PayerAccountIDs, &payerID)
+		}
+		record.AssessedCustomFees = append(record.AssessedCustomFees, assessedFee)
+	}
+
+	for _, reward := range transaction.StakingRewardTransfers {
+		accountID, err := AccountIDFromString(reward.Account)
+		if err != nil {
+			return TransactionRecord{}, err
+		}
+		record.PaidStakingRewards[accountID] = HbarFromTinybar(reward.Amount)
+	}
+
+	return record, nil
+}
+
+// _TransactionReceiptFromMirror returns the receipt of a mirror node transaction. The created entity is taken from
+// the entity ID of the transactions which create one, and the minted serial numbers from the NFT transfers.
+func _TransactionReceiptFromMirror(transaction mirror.Transaction, transactionID TransactionID) (TransactionReceipt, error) {
+	status, ok := services.ResponseCodeEnum_value[transaction.Result]
+	if !ok {
+		return TransactionReceipt{}, fmt.Errorf("unknown transaction result %q", transaction.Result)
+	}
+
+	receipt := TransactionReceipt{
+		Status:        Status(status),
+		TransactionID: &transactionID,
+		SerialNumbers: make([]int64, 0),
+		Children:      make([]TransactionReceipt, 0),
+		Duplicates:    make([]TransactionReceipt, 0),
+	}
+
+	if transaction.EntityID != nil && receipt.Status == StatusSuccess {
+		var err error
+		switch transaction.Name {
+		case "CRYPTOCREATEACCOUNT":
+			var accountID AccountID
+			accountID, err = AccountIDFromString(*transaction.EntityID)
+			receipt.AccountID = &accountID
+		case "TOKENCREATION":
+			var tokenID TokenID
+			tokenID, err = TokenIDFromString(*transaction.EntityID)
+			receipt.TokenID = &tokenID
+		case "CONSENSUSCREATETOPIC":
+			var topicID TopicID
+			topicID, err = TopicIDFromString(*transaction.EntityID)
+			receipt.TopicID = &topicID
+		case "FILECREATE":
+			var fileID FileID
+			fileID, err = FileIDFromString(*transaction.EntityID)
+			receipt.FileID = &fileID
+		case "CONTRACTCREATEINSTANCE":
+			var contractID ContractID
+			contractID, err = ContractIDFromString(*transaction.EntityID)
+			receipt.ContractID = &contractID
+		case "SCHEDULECREATE":
+			var scheduleID ScheduleID
+			scheduleID, err = ScheduleIDFromString(*transaction.EntityID)
+			receipt.ScheduleID = &scheduleID
+			scheduledTransactionID := transactionID.SetScheduled(true)
+			receipt.ScheduledTransactionID = &scheduledTransactionID
+		}
+		if err != nil {
+			return TransactionReceipt{}, err
+		}
+	}
+
+	if transaction.Name == "TOKENMINT" {
+		for _, transfer := range transaction.NftTransfers {
+			if transfer.SenderAccountID == nil {
+				receipt.SerialNumbers = append(receipt.SerialNumbers, transfer.SerialNumber)
+			}
+		}
+	}
+
+	return receipt, nil
+}
+
+func _AccountIDFromMirrorPointer(accountID *string) (AccountID, error) {
+	if accountID == nil {
+		return AccountID{}, nil
+	}
+
+	return AccountIDFromString(*accountID)
+}
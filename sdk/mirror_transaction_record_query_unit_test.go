@@ -0,0 +1,147 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type _MirrorRoundTripper func(request *http.Request) (*http.Response, error)
+
+func (roundTripper _MirrorRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	return roundTripper(request)
+}
+
+// _NewMirrorTestClient returns a client whose mirror REST requests are sent to server.
+func _NewMirrorTestClient(t *testing.T, server *httptest.Server) *Client {
+	target, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	client := ClientForNetwork(map[string]AccountID{})
+	client.SetMirrorNetwork([]string{"mirror.example.com:443"})
+	client.SetMirrorHTTPClient(&http.Client{
+		Transport: _MirrorRoundTripper(func(request *http.Request) (*http.Response, error) {
+			request.URL.Scheme = target.Scheme
+			request.URL.Host = target.Host
+			return http.DefaultTransport.RoundTrip(request)
+		}),
+	})
+
+	return client
+}
+
+const mirrorTransactionsResponse = `{"transactions":[
+	{"consensus_timestamp":"1700000005.000000002","charged_tx_fee":84650,"memo_base64":"aGVsbG8=","name":"TOKENMINT",
+	 "nonce":0,"result":"SUCCESS","scheduled":false,"transaction_hash":"AQID","transaction_id":"0.0.2-1700000000-000000001",
+	 "entity_id":"0.0.1001",
+	 "transfers":[{"account":"0.0.2","amount":-84650,"is_approval":false},{"account":"0.0.98","amount":84650,"is_approval":false}],
+	 "token_transfers":[{"token_id":"0.0.1002","account":"0.0.2","amount":-10,"is_approval":true},{"token_id":"0.0.1002","account":"0.0.3","amount":10,"is_approval":false}],
+	 "nft_transfers":[{"receiver_account_id":"0.0.2","sender_account_id":null,"serial_number":4,"token_id":"0.0.1001","is_approval":false}],
+	 "assessed_custom_fees":[{"amount":5,"collector_account_id":"0.0.9","effective_payer_account_ids":["0.0.2"],"token_id":"0.0.1002"}],
+	 "staking_reward_transfers":[{"account":"0.0.2","amount":7}]},
+	{"consensus_timestamp":"1700000005.000000003","charged_tx_fee":0,"memo_base64":"","name":"CRYPTOCREATEACCOUNT",
+	 "nonce":1,"result":"SUCCESS","scheduled":false,"transaction_hash":"","transaction_id":"0.0.2-1700000000-000000001",
+	 "entity_id":"0.0.1003","parent_consensus_timestamp":"1700000005.000000002","transfers":[]}
+]}`
+
+func TestUnitMirrorTransactionRecordQuery(t *testing.T) {
+	t.Parallel()
+
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/transactions/0.0.2-1700000000-000000001", r.URL.Path)
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(mirrorTransactionsResponse))
+	}))
+	defer server.Close()
+
+	client := _NewMirrorTestClient(t, server)
+	transactionID := TransactionIDGenerate(AccountID{Account: 2})
+	validStart := time.Unix(1700000000, 1)
+	transactionID.ValidStart = &validStart
+
+	record, err := NewMirrorTransactionRecordQuery().
+		SetTransactionID(transactionID).
+		SetIncludeChildren(true).
+		Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, "false", query.Get("scheduled"))
+	assert.Empty(t, query.Get("nonce"))
+
+	assert.Equal(t, StatusSuccess, record.Receipt.Status)
+	assert.Equal(t, []int64{4}, record.Receipt.SerialNumbers)
+	assert.Equal(t, transactionID.String(), record.TransactionID.String())
+	assert.Equal(t, []byte{1, 2, 3}, record.TransactionHash)
+	assert.Equal(t, "hello", record.TransactionMemo)
+	assert.Equal(t, HbarFromTinybar(84650), record.TransactionFee)
+	assert.True(t, time.Unix(1700000005, 2).Equal(record.ConsensusTimestamp))
+	require.Len(t, record.Transfers, 2)
+	assert.Equal(t, AccountID{Account: 98}, record.Transfers[1].AccountID)
+	assert.Equal(t, HbarFromTinybar(84650), record.Transfers[1].Amount)
+	require.Len(t, record.TokenTransfers[TokenID{Token: 1002}], 2)
+	assert.True(t, record.TokenTransfers[TokenID{Token: 1002}][0].IsApproved)
+	require.Len(t, record.NftTransfers[TokenID{Token: 1001}], 1)
+	assert.Equal(t, AccountID{}, record.NftTransfers[TokenID{Token: 1001}][0].SenderAccountID)
+	assert.Equal(t, AccountID{Account: 2}, record.NftTransfers[TokenID{Token: 1001}][0].ReceiverAccountID)
+	require.Len(t, record.AssessedCustomFees, 1)
+	assert.Equal(t, TokenID{Token: 1002}, *record.AssessedCustomFees[0].TokenID)
+	assert.Equal(t, AccountID{Account: 9}, *record.AssessedCustomFees[0].FeeCollectorAccountId)
+	assert.Equal(t, HbarFromTinybar(7), record.PaidStakingRewards[AccountID{Account: 2}])
+
+	require.Len(t, record.Children, 1)
+	child := record.Children[0]
+	assert.Equal(t, int32(1), *child.TransactionID.Nonce)
+	assert.Equal(t, AccountID{Account: 1003}, *child.Receipt.AccountID)
+	assert.True(t, time.Unix(1700000005, 2).Equal(child.ParentConsensusTimestamp))
+	require.Len(t, record.Receipt.Children, 1)
+
+	// Without children only the transaction itself is requested
+	_, err = NewMirrorTransactionRecordQuery().SetTransactionID(transactionID).Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, "0", query.Get("nonce"))
+}
+
+func TestUnitMirrorTransactionRecordQueryErrors(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("scheduled") == "true" {
+			_, _ = w.Write([]byte(`{"transactions":[{"consensus_timestamp":"1700000005.000000002","name":"CRYPTOTRANSFER",
+				"nonce":0,"result":"INSUFFICIENT_ACCOUNT_BALANCE","scheduled":true,"transaction_hash":"","memo_base64":"",
+				"transaction_id":"0.0.2-1700000000-000000001"}]}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"_status":{"messages":[{"message":"Not found"}]}}`))
+	}))
+	defer server.Close()
+
+	client := _NewMirrorTestClient(t, server)
+	validStart := time.Unix(1700000000, 1)
+	transactionID := TransactionID{AccountID: &AccountID{Account: 2}, ValidStart: &validStart}
+
+	_, err := NewMirrorTransactionRecordQuery().SetTransactionID(transactionID).Execute(client)
+	var precheckErr ErrHederaPreCheckStatus
+	require.ErrorAs(t, err, &precheckErr)
+	assert.Equal(t, StatusRecordNotFound, precheckErr.Status)
+
+	record, err := NewMirrorTransactionRecordQuery().SetTransactionID(transactionID.SetScheduled(true)).Execute(client)
+	var receiptErr ErrHederaReceiptStatus
+	require.ErrorAs(t, err, &receiptErr)
+	assert.Equal(t, StatusInsufficientAccountBalance, receiptErr.Status)
+	assert.Equal(t, StatusInsufficientAccountBalance, record.Receipt.Status)
+	assert.True(t, record.TransactionID.GetScheduled())
+
+	_, err = NewMirrorTransactionRecordQuery().Execute(client)
+	require.Error(t, err)
+}
@@ -40,6 +40,23 @@ func (version *NetworkVersionInfo) ToBytes() []byte {
 	return data
 }
 
+// MarshalJSON returns the protobuf JSON mapping of the proto.NetworkGetVersionInfoResponse message for the NetworkVersionInfo.
+func (version NetworkVersionInfo) MarshalJSON() ([]byte, error) {
+	return _ProtobufToJSON(version._ToProtobuf())
+}
+
+// UnmarshalJSON decodes a NetworkVersionInfo from the protobuf JSON mapping of the proto.NetworkGetVersionInfoResponse message.
+func (version *NetworkVersionInfo) UnmarshalJSON(data []byte) error {
+	pb := services.NetworkGetVersionInfoResponse{}
+	if err := _ProtobufFromJSON(data, &pb); err != nil {
+		return err
+	}
+
+	*version = _NetworkVersionInfoFromProtobuf(&pb)
+
+	return nil
+}
+
 // NetworkVersionInfoFromBytes returns the NetworkVersionInfo from a raw byte array
 func NetworkVersionInfoFromBytes(data []byte) (NetworkVersionInfo, error) {
 	if data == nil {
@@ -109,6 +109,28 @@ func (id NftID) ToBytes() []byte {
 	return data
 }
 
+// MarshalJSON implements the encoding.JSON interface.
+func (id NftID) MarshalJSON() ([]byte, error) {
+	return _StringToJSON(id.String())
+}
+
+// UnmarshalJSON implements the encoding.JSON interface.
+func (id *NftID) UnmarshalJSON(data []byte) error {
+	value, err := _StringFromJSON(data)
+	if err != nil {
+		return err
+	}
+
+	result, err := NftIDFromString(value)
+	if err != nil {
+		return err
+	}
+
+	*id = result
+
+	return nil
+}
+
 // NftIDFromBytes returns the NftID from a raw byte array
 func NftIDFromBytes(data []byte) (NftID, error) {
 	pb := services.NftID{}
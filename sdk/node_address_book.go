@@ -46,6 +46,23 @@ func (book NodeAddressBook) ToBytes() []byte {
 	return data
 }
 
+// MarshalJSON returns the protobuf JSON mapping of the proto.NodeAddressBook message for the NodeAddressBook.
+func (book NodeAddressBook) MarshalJSON() ([]byte, error) {
+	return _ProtobufToJSON(book._ToProtobuf())
+}
+
+// UnmarshalJSON decodes a NodeAddressBook from the protobuf JSON mapping of the proto.NodeAddressBook message.
+func (book *NodeAddressBook) UnmarshalJSON(data []byte) error {
+	pb := services.NodeAddressBook{}
+	if err := _ProtobufFromJSON(data, &pb); err != nil {
+		return err
+	}
+
+	*book = _NodeAddressBookFromProtobuf(&pb)
+
+	return nil
+}
+
 func (book NodeAddressBook) _ToMap() (result map[AccountID]NodeAddress) {
 	result = map[AccountID]NodeAddress{}
 
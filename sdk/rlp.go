@@ -168,7 +168,7 @@ func (item *RLPItem) decodeBytes(bytes []byte, index *int) error {
 	}
 
 	// Short list case
-	if prefix < 0xF7 {
+	if prefix <= 0xF7 {
 		listLength := int(prefix) - 0xC0
 		startIndex := *index
 		for *index < startIndex+listLength {
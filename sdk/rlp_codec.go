@@ -0,0 +1,471 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var bigIntType = reflect.TypeOf(big.Int{})
+
+// RLPEncode returns the RLP encoding of v, which can be
+//   - an unsigned integer, a big.Int or a bool, encoded as a minimal big endian string
+//   - a string, a byte slice or a byte array, encoded as a string
+//   - a slice or an array, encoded as a list of its elements
+//   - a struct, encoded as a list of its exported fields
+//   - a pointer or an interface holding one of the above
+//
+// Struct fields are configured with the rlp tag:
+//   - `rlp:"-"` skips the field
+//   - `rlp:"uint"` encodes a byte slice as an unsigned integer, without leading zero bytes
+//   - `rlp:"optional"` omits the field when it and all the fields after it are zero. Once a field is optional,
+//     all the fields after it must be optional too.
+//   - `rlp:"tail"` on the last field, a slice, encodes its elements as the remaining elements of the struct list
+func RLPEncode(v interface{}) ([]byte, error) {
+	return rlpEncodeValue(reflect.ValueOf(v), false)
+}
+
+// RLPDecode decodes the RLP encoded data into the value out points to, as the inverse of RLPEncode. Only canonical
+// encodings are accepted: lengths must use the shortest form, single bytes below 0x80 must be encoded as themselves
+// and integers mustn't have leading zero bytes. Optional struct fields missing from the end of a list are left as is.
+func RLPDecode(data []byte, out interface{}) error {
+	v := reflect.ValueOf(out)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return errors.New("rlp: out must be a non-nil pointer")
+	}
+
+	isList, content, rest, err := rlpSplit(data)
+	if err != nil {
+		return err
+	}
+	if len(rest) > 0 {
+		return errors.New("rlp: trailing data after the encoded value")
+	}
+
+	return rlpDecodeValue(isList, content, v.Elem(), false)
+}
+
+type rlpField struct {
+	index    int
+	name     string
+	uint     bool
+	optional bool
+	tail     bool
+}
+
+func rlpStructFields(typ reflect.Type) ([]rlpField, error) {
+	var fields []rlpField
+	optional := false
+	for i := 0; i < typ.NumField(); i++ {
+		structField := typ.Field(i)
+		if structField.PkgPath != "" {
+			continue
+		}
+
+		field := rlpField{index: i, name: structField.Name}
+		tag := structField.Tag.Get("rlp")
+		if tag == "-" {
+			continue
+		}
+		for _, option := range strings.Split(tag, ",") {
+			switch strings.TrimSpace(option) {
+			case "":
+			case "uint":
+				field.uint = true
+			case "optional":
+				field.optional = true
+			case "tail":
+				field.tail = true
+			default:
+				return nil, fmt.Errorf("rlp: unknown option %q in the tag of %s.%s", option, typ, structField.Name)
+			}
+		}
+
+		if len(fields) > 0 && fields[len(fields)-1].tail {
+			return nil, fmt.Errorf("rlp: tail field %s.%s must be the last field", typ, fields[len(fields)-1].name)
+		}
+		if field.tail && structField.Type.Kind() != reflect.Slice {
+			return nil, fmt.Errorf("rlp: tail field %s.%s must be a slice", typ, structField.Name)
+		}
+		if optional && !field.optional && !field.tail {
+			return nil, fmt.Errorf("rlp: field %s.%s must be optional as it follows an optional field", typ, structField.Name)
+		}
+		optional = optional || field.optional
+
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+func rlpEncodeValue(v reflect.Value, uintBytes bool) ([]byte, error) {
+	if !v.IsValid() {
+		return nil, errors.New("rlp: cannot encode a nil value")
+	}
+
+	if v.Type() == bigIntType {
+		value := v.Interface().(big.Int)
+		return rlpEncodeBigInt(&value)
+	}
+
+	switch v.Kind() {
+	case reflect.Interface:
+		return rlpEncodeValue(v.Elem(), uintBytes)
+
+	case reflect.Ptr:
+		if v.IsNil() {
+			return rlpEncodeValue(reflect.Zero(v.Type().Elem()), uintBytes)
+		}
+		return rlpEncodeValue(v.Elem(), uintBytes)
+
+	case reflect.Bool:
+		if v.Bool() {
+			return []byte{0x01}, nil
+		}
+		return []byte{0x80}, nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return rlpEncodeString(encodeBinary(v.Uint())), nil
+
+	case reflect.String:
+		return rlpEncodeString([]byte(v.String())), nil
+
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			bytes := v.Bytes()
+			if uintBytes {
+				bytes = rlpTrimLeadingZeros(bytes)
+			}
+			return rlpEncodeString(bytes), nil
+		}
+		return rlpEncodeList(v)
+
+	case reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			bytes := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(bytes), v)
+			return rlpEncodeString(bytes), nil
+		}
+		return rlpEncodeList(v)
+
+	case reflect.Struct:
+		return rlpEncodeStruct(v)
+
+	default:
+		return nil, fmt.Errorf("rlp: unsupported type %s", v.Type())
+	}
+}
+
+func rlpEncodeStruct(v reflect.Value) ([]byte, error) {
+	fields, err := rlpStructFields(v.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	// Optional fields are omitted from the end as long as they are zero
+	end := len(fields)
+	for end > 0 && (fields[end-1].optional || fields[end-1].tail) && v.Field(fields[end-1].index).IsZero() {
+		end--
+	}
+
+	var payload []byte
+	for _, field := range fields[:end] {
+		value := v.Field(field.index)
+		if field.tail {
+			for i := 0; i < value.Len(); i++ {
+				elem, err := rlpEncodeValue(value.Index(i), false)
+				if err != nil {
+					return nil, err
+				}
+				payload = append(payload, elem...)
+			}
+			continue
+		}
+
+		encoded, err := rlpEncodeValue(value, field.uint)
+		if err != nil {
+			return nil, errors.Wrapf(err, "field %s", field.name)
+		}
+		payload = append(payload, encoded...)
+	}
+
+	return append(encodeLength(len(payload), 0xC0), payload...), nil
+}
+
+func rlpEncodeList(v reflect.Value) ([]byte, error) {
+	var payload []byte
+	for i := 0; i < v.Len(); i++ {
+		elem, err := rlpEncodeValue(v.Index(i), false)
+		if err != nil {
+			return nil, err
+		}
+		payload = append(payload, elem...)
+	}
+
+	return append(encodeLength(len(payload), 0xC0), payload...), nil
+}
+
+func rlpEncodeString(bytes []byte) []byte {
+	if len(bytes) == 1 && bytes[0] < 0x80 {
+		return []byte{bytes[0]}
+	}
+
+	return append(encodeLength(len(bytes), 0x80), bytes...)
+}
+
+func rlpEncodeBigInt(value *big.Int) ([]byte, error) {
+	if value.Sign() < 0 {
+		return nil, errors.New("rlp: cannot encode a negative integer")
+	}
+
+	return rlpEncodeString(value.Bytes()), nil
+}
+
+func rlpTrimLeadingZeros(bytes []byte) []byte {
+	for len(bytes) > 0 && bytes[0] == 0 {
+		bytes = bytes[1:]
+	}
+	return bytes
+}
+
+// rlpSplit splits the first item of the data from the rest, checking that its header is canonical
+func rlpSplit(data []byte) (isList bool, content []byte, rest []byte, err error) {
+	if len(data) == 0 {
+		return false, nil, nil, errors.New("rlp: unexpected end of input")
+	}
+
+	prefix := data[0]
+	var offset, size uint64
+	switch {
+	case prefix < 0x80:
+		return false, data[:1], data[1:], nil
+
+	case prefix < 0xB8:
+		offset, size = 1, uint64(prefix-0x80)
+		if size == 1 && len(data) > 1 && data[1] < 0x80 {
+			return false, nil, nil, errors.New("rlp: non-canonical single byte, it must be encoded as itself")
+		}
+
+	case prefix < 0xC0:
+		if offset, size, err = rlpReadLongSize(data, prefix-0xB7); err != nil {
+			return false, nil, nil, err
+		}
+
+	case prefix < 0xF8:
+		isList = true
+		offset, size = 1, uint64(prefix-0xC0)
+
+	default:
+		isList = true
+		if offset, size, err = rlpReadLongSize(data, prefix-0xF7); err != nil {
+			return false, nil, nil, err
+		}
+	}
+
+	if size > uint64(len(data))-offset {
+		return false, nil, nil, errors.New("rlp: value size exceeds the input")
+	}
+
+	return isList, data[offset : offset+size], data[offset+size:], nil
+}
+
+func rlpReadLongSize(data []byte, sizeOfSize byte) (uint64, uint64, error) {
+	if int(sizeOfSize) >= len(data) {
+		return 0, 0, errors.New("rlp: unexpected end of input")
+	}
+	if data[1] == 0 {
+		return 0, 0, errors.New("rlp: non-canonical size with leading zero bytes")
+	}
+	if sizeOfSize > 8 {
+		return 0, 0, errors.New("rlp: size is too large")
+	}
+
+	var size uint64
+	for _, b := range data[1 : 1+sizeOfSize] {
+		size = size<<8 | uint64(b)
+	}
+	if size < 56 {
+		return 0, 0, errors.New("rlp: non-canonical size, it must use the short form")
+	}
+
+	return 1 + uint64(sizeOfSize), size, nil
+}
+
+func rlpDecodeValue(isList bool, content []byte, v reflect.Value, uintBytes bool) error {
+	typ := v.Type()
+	if typ == bigIntType {
+		if err := rlpCheckInteger(isList, content); err != nil {
+			return err
+		}
+		v.Addr().Interface().(*big.Int).SetBytes(content)
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			v.Set(reflect.New(typ.Elem()))
+		}
+		return rlpDecodeValue(isList, content, v.Elem(), uintBytes)
+
+	case reflect.Bool:
+		if isList || len(content) > 1 || (len(content) == 1 && content[0] != 0x01) {
+			return fmt.Errorf("rlp: invalid boolean %x", content)
+		}
+		v.SetBool(len(content) == 1)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if err := rlpCheckInteger(isList, content); err != nil {
+			return err
+		}
+		if len(content) > int(typ.Size()) {
+			return fmt.Errorf("rlp: integer of %d bytes overflows %s", len(content), typ)
+		}
+		var value uint64
+		for _, b := range content {
+			value = value<<8 | uint64(b)
+		}
+		v.SetUint(value)
+
+	case reflect.String:
+		if isList {
+			return fmt.Errorf("rlp: expected a string for %s, got a list", typ)
+		}
+		v.SetString(string(content))
+
+	case reflect.Slice:
+		if typ.Elem().Kind() == reflect.Uint8 {
+			if isList {
+				return fmt.Errorf("rlp: expected a string for %s, got a list", typ)
+			}
+			if uintBytes {
+				if err := rlpCheckInteger(isList, content); err != nil {
+					return err
+				}
+			}
+			v.SetBytes(append([]byte(nil), content...))
+			return nil
+		}
+		if !isList {
+			return fmt.Errorf("rlp: expected a list for %s, got a string", typ)
+		}
+		return rlpDecodeSlice(content, v)
+
+	case reflect.Array:
+		if typ.Elem().Kind() == reflect.Uint8 {
+			if isList {
+				return fmt.Errorf("rlp: expected a string for %s, got a list", typ)
+			}
+			if len(content) != v.Len() {
+				return fmt.Errorf("rlp: expected %d bytes for %s, got %d", v.Len(), typ, len(content))
+			}
+			reflect.Copy(v, reflect.ValueOf(content))
+			return nil
+		}
+		if !isList {
+			return fmt.Errorf("rlp: expected a list for %s, got a string", typ)
+		}
+		for i := 0; i < v.Len(); i++ {
+			if len(content) == 0 {
+				return fmt.Errorf("rlp: too few elements for %s", typ)
+			}
+			elemIsList, elem, rest, err := rlpSplit(content)
+			if err != nil {
+				return err
+			}
+			if err := rlpDecodeValue(elemIsList, elem, v.Index(i), false); err != nil {
+				return err
+			}
+			content = rest
+		}
+		if len(content) > 0 {
+			return fmt.Errorf("rlp: too many elements for %s", typ)
+		}
+
+	case reflect.Struct:
+		if !isList {
+			return fmt.Errorf("rlp: expected a list for %s, got a string", typ)
+		}
+		return rlpDecodeStruct(content, v)
+
+	default:
+		return fmt.Errorf("rlp: unsupported type %s", typ)
+	}
+
+	return nil
+}
+
+func rlpDecodeStruct(content []byte, v reflect.Value) error {
+	fields, err := rlpStructFields(v.Type())
+	if err != nil {
+		return err
+	}
+
+	for _, field := range fields {
+		if field.tail {
+			return rlpDecodeSlice(content, v.Field(field.index))
+		}
+
+		if len(content) == 0 {
+			if field.optional {
+				return nil
+			}
+			return fmt.Errorf("rlp: too few elements for %s, missing %s", v.Type(), field.name)
+		}
+
+		isList, elem, rest, err := rlpSplit(content)
+		if err != nil {
+			return err
+		}
+		if err := rlpDecodeValue(isList, elem, v.Field(field.index), field.uint); err != nil {
+			return errors.Wrapf(err, "field %s", field.name)
+		}
+		content = rest
+	}
+
+	if len(content) > 0 {
+		return fmt.Errorf("rlp: too many elements for %s", v.Type())
+	}
+
+	return nil
+}
+
+func rlpDecodeSlice(content []byte, v reflect.Value) error {
+	slice := reflect.MakeSlice(v.Type(), 0, 0)
+	for len(content) > 0 {
+		isList, elem, rest, err := rlpSplit(content)
+		if err != nil {
+			return err
+		}
+
+		value := reflect.New(v.Type().Elem()).Elem()
+		if err := rlpDecodeValue(isList, elem, value, false); err != nil {
+			return err
+		}
+		slice = reflect.Append(slice, value)
+		content = rest
+	}
+
+	if slice.Len() == 0 {
+		slice = reflect.Zero(v.Type())
+	}
+	v.Set(slice)
+
+	return nil
+}
+
+// rlpCheckInteger checks that an integer is a string without leading zero bytes
+func rlpCheckInteger(isList bool, content []byte) error {
+	if isList {
+		return errors.New("rlp: expected an integer, got a list")
+	}
+	if len(content) > 0 && content[0] == 0 {
+		return errors.New("rlp: non-canonical integer with leading zero bytes")
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitRLPEncode(t *testing.T) {
+	t.Parallel()
+
+	lorem := "Lorem ipsum dolor sit amet, consectetur adipisicing elit"
+	tests := []struct {
+		value    interface{}
+		expected string
+	}{
+		{uint64(0), "80"},
+		{uint8(15), "0f"},
+		{uint16(1024), "820400"},
+		{big.NewInt(0), "80"},
+		{new(big.Int).Lsh(big.NewInt(1), 64), "89010000000000000000"},
+		{(*big.Int)(nil), "80"},
+		{true, "01"},
+		{false, "80"},
+		{"", "80"},
+		{"dog", "83646f67"},
+		{[]byte{0x00}, "00"},
+		{[]byte{0x80}, "8180"},
+		{[2]byte{0, 1}, "820001"},
+		{lorem, "b838" + hex.EncodeToString([]byte(lorem))},
+		{[]string{"cat", "dog"}, "c88363617483646f67"},
+		{[]string{}, "c0"},
+		// The set theoretical representation of three
+		{[]interface{}{[]interface{}{}, []interface{}{[]interface{}{}}, []interface{}{[]interface{}{}, []interface{}{[]interface{}{}}}}, "c7c0c1c0c3c0c1c0"},
+		{[]string{strings.Repeat("a", 55)}, "f838b7" + strings.Repeat("61", 55)},
+	}
+
+	for _, test := range tests {
+		encoded, err := RLPEncode(test.value)
+		require.NoError(t, err)
+		assert.Equal(t, test.expected, hex.EncodeToString(encoded), "%#v", test.value)
+	}
+
+	_, err := RLPEncode(big.NewInt(-1))
+	require.Error(t, err)
+	_, err = RLPEncode(int64(1))
+	require.Error(t, err)
+	_, err = RLPEncode(nil)
+	require.Error(t, err)
+}
+
+type rlpTestStruct struct {
+	Number   uint64
+	Amount   []byte `rlp:"uint"`
+	Name     string
+	Skipped  string `rlp:"-"`
+	Flags    []bool
+	Optional *big.Int `rlp:"optional"`
+	Rest     []uint16 `rlp:"tail"`
+	internal string
+}
+
+func TestUnitRLPStruct(t *testing.T) {
+	t.Parallel()
+
+	value := rlpTestStruct{Number: 1, Amount: []byte{0, 0, 5}, Name: "a", Skipped: "x", Flags: []bool{true, false}, internal: "y"}
+	encoded, err := RLPEncode(value)
+	require.NoError(t, err)
+	assert.Equal(t, "c60105"+"61"+"c20180", hex.EncodeToString(encoded))
+
+	var decoded rlpTestStruct
+	require.NoError(t, RLPDecode(encoded, &decoded))
+	assert.Equal(t, rlpTestStruct{Number: 1, Amount: []byte{5}, Name: "a", Flags: []bool{true, false}}, decoded)
+
+	// Zero optional fields are only omitted from the end
+	value.Rest = []uint16{7, 1024}
+	encoded, err = RLPEncode(&value)
+	require.NoError(t, err)
+	assert.Equal(t, "cb0105"+"61"+"c20180"+"80"+"07"+"820400", hex.EncodeToString(encoded))
+
+	decoded = rlpTestStruct{}
+	require.NoError(t, RLPDecode(encoded, &decoded))
+	assert.Equal(t, big.NewInt(0), decoded.Optional)
+	assert.Equal(t, []uint16{7, 1024}, decoded.Rest)
+
+	var pointer *rlpTestStruct
+	require.NoError(t, RLPDecode(encoded, &pointer))
+	assert.Equal(t, decoded, *pointer)
+
+	var missing struct {
+		A uint64
+		B uint64
+	}
+	require.ErrorContains(t, RLPDecode([]byte{0xc1, 0x01}, &missing), "too few elements")
+	require.ErrorContains(t, RLPDecode([]byte{0xc3, 0x01, 0x02, 0x03}, &missing), "too many elements")
+
+	var invalid struct {
+		A uint64 `rlp:"optional"`
+		B uint64
+	}
+	_, err = RLPEncode(invalid)
+	require.Error(t, err)
+}
+
+func TestUnitRLPDecodeCanonical(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		out   interface{}
+	}{
+		{"single byte in a string", "8105", new([]byte)},
+		{"short string in the long form", "b801" + "61", new(string)},
+		{"size with leading zeros", "b90038" + strings.Repeat("61", 56), new(string)},
+		{"short list in the long form", "f80101", new([]uint64)},
+		{"integer with leading zeros", "820001", new(uint64)},
+		{"zero as 0x00", "00", new(big.Int)},
+		{"byte slice integer with leading zeros", "c28200", new(struct {
+			A []byte `rlp:"uint"`
+		})},
+		{"integer overflow", "820100", new(uint8)},
+		{"wrong byte array size", "820102", new([3]byte)},
+		{"string for a list", "83646f67", new([]string)},
+		{"list for a string", "c0", new(string)},
+		{"truncated value", "83646f", new(string)},
+		{"trailing data", "0102", new(uint64)},
+		{"empty input", "", new(uint64)},
+		{"invalid boolean", "02", new(bool)},
+	}
+
+	for _, test := range tests {
+		input, err := hex.DecodeString(test.input)
+		require.NoError(t, err)
+		assert.Error(t, RLPDecode(input, test.out), test.name)
+	}
+
+	require.Error(t, RLPDecode([]byte{0x80}, uint64(0)))
+
+	var number uint64
+	require.NoError(t, RLPDecode([]byte{0x82, 0x04, 0x00}, &number))
+	assert.Equal(t, uint64(1024), number)
+
+	var text string
+	lorem := "Lorem ipsum dolor sit amet, consectetur adipisicing elit"
+	require.NoError(t, RLPDecode(append([]byte{0xb8, 0x38}, lorem...), &text))
+	assert.Equal(t, lorem, text)
+
+	var array [2]uint64
+	require.NoError(t, RLPDecode([]byte{0xc2, 0x01, 0x02}, &array))
+	assert.Equal(t, [2]uint64{1, 2}, array)
+	require.Error(t, RLPDecode([]byte{0xc1, 0x01}, &array))
+}
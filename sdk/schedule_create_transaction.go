@@ -181,6 +181,14 @@ func (tx ScheduleCreateTransaction) getName() string {
 	return "ScheduleCreateTransaction"
 }
 
+func (tx ScheduleCreateTransaction) validateBody() []ErrTransactionValidation {
+	if tx.memo == nil {
+		return nil
+	}
+
+	return _ValidateMemo("schedule memo", *tx.memo)
+}
+
 func (tx ScheduleCreateTransaction) validateNetworkOnIDs(client *Client) error {
 	if client == nil || !client.autoValidateChecksums {
 		return nil
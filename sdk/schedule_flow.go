@@ -0,0 +1,381 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// scheduleFlowExpiryGrace is how long ScheduleFlow keeps polling a schedule after its expiration time
+// before it considers the schedule expired.
+const scheduleFlowExpiryGrace = 30 * time.Second
+
+var errScheduleFlowNoTransaction = errors.New("scheduled transaction must be set before executing the ScheduleFlow")
+
+type _ScheduleFlowSigner struct {
+	publicKey PublicKey
+	signer    TransactionSigner
+}
+
+// ScheduleFlow creates a schedule for a transaction, or signs the identical schedule if one already exists,
+// and waits for the scheduled transaction to execute. It returns the record of the scheduled transaction.
+type ScheduleFlow struct {
+	scheduledTransaction TransactionInterface
+	payerAccountID       *AccountID
+	adminKey             Key
+	memo                 string
+	expirationTime       *time.Time
+	waitForExpiry        bool
+	signers              []_ScheduleFlowSigner
+	pollInterval         time.Duration
+	nodeAccountIDs       []AccountID
+}
+
+// NewScheduleFlow creates a new ScheduleFlow builder object.
+func NewScheduleFlow() *ScheduleFlow {
+	return &ScheduleFlow{
+		pollInterval: 2 * time.Second,
+	}
+}
+
+// SetScheduledTransaction sets the transaction to be scheduled.
+func (flow *ScheduleFlow) SetScheduledTransaction(tx TransactionInterface) *ScheduleFlow {
+	flow.scheduledTransaction = tx
+	return flow
+}
+
+// GetScheduledTransaction returns the transaction to be scheduled.
+func (flow *ScheduleFlow) GetScheduledTransaction() TransactionInterface {
+	return flow.scheduledTransaction
+}
+
+// SetPayerAccountID sets the account that pays for the scheduled transaction when it executes.
+func (flow *ScheduleFlow) SetPayerAccountID(payerAccountID AccountID) *ScheduleFlow {
+	flow.payerAccountID = &payerAccountID
+	return flow
+}
+
+// GetPayerAccountID returns the account that pays for the scheduled transaction when it executes.
+func (flow *ScheduleFlow) GetPayerAccountID() AccountID {
+	if flow.payerAccountID == nil {
+		return AccountID{}
+	}
+
+	return *flow.payerAccountID
+}
+
+// SetAdminKey sets the key which can be used to delete the schedule.
+func (flow *ScheduleFlow) SetAdminKey(key Key) *ScheduleFlow {
+	flow.adminKey = key
+	return flow
+}
+
+// GetAdminKey returns the key which can be used to delete the schedule.
+func (flow *ScheduleFlow) GetAdminKey() Key {
+	return flow.adminKey
+}
+
+// SetScheduleMemo sets the memo of the schedule entity.
+func (flow *ScheduleFlow) SetScheduleMemo(memo string) *ScheduleFlow {
+	flow.memo = memo
+	return flow
+}
+
+// GetScheduleMemo returns the memo of the schedule entity.
+func (flow *ScheduleFlow) GetScheduleMemo() string {
+	return flow.memo
+}
+
+// SetExpirationTime sets the time at which the schedule expires.
+func (flow *ScheduleFlow) SetExpirationTime(expirationTime time.Time) *ScheduleFlow {
+	flow.expirationTime = &expirationTime
+	return flow
+}
+
+// GetExpirationTime returns the time at which the schedule expires.
+func (flow *ScheduleFlow) GetExpirationTime() time.Time {
+	if flow.expirationTime == nil {
+		return time.Time{}
+	}
+
+	return *flow.expirationTime
+}
+
+// SetWaitForExpiry sets whether the scheduled transaction executes at its expiration time instead of
+// as soon as it is signed by all required keys. The flow then waits until the expiration time before
+// polling the schedule.
+func (flow *ScheduleFlow) SetWaitForExpiry(wait bool) *ScheduleFlow {
+	flow.waitForExpiry = wait
+	return flow
+}
+
+// GetWaitForExpiry returns whether the scheduled transaction executes at its expiration time.
+func (flow *ScheduleFlow) GetWaitForExpiry() bool {
+	return flow.waitForExpiry
+}
+
+// Sign adds a key whose signature is added to the schedule create or schedule sign transaction.
+func (flow *ScheduleFlow) Sign(privateKey PrivateKey) *ScheduleFlow {
+	return flow.SignWith(privateKey.PublicKey(), privateKey.Sign)
+}
+
+// SignWith adds a public key and signer whose signature is added to the schedule create or schedule sign transaction.
+func (flow *ScheduleFlow) SignWith(publicKey PublicKey, signer TransactionSigner) *ScheduleFlow {
+	flow.signers = append(flow.signers, _ScheduleFlowSigner{publicKey: publicKey, signer: signer})
+	return flow
+}
+
+// SetPollInterval sets how often the schedule is queried while waiting for it to execute.
+func (flow *ScheduleFlow) SetPollInterval(interval time.Duration) *ScheduleFlow {
+	flow.pollInterval = interval
+	return flow
+}
+
+// GetPollInterval returns how often the schedule is queried while waiting for it to execute.
+func (flow *ScheduleFlow) GetPollInterval() time.Duration {
+	return flow.pollInterval
+}
+
+// SetNodeAccountIDs sets the node AccountID for the transactions of this ScheduleFlow.
+func (flow *ScheduleFlow) SetNodeAccountIDs(nodeID []AccountID) *ScheduleFlow {
+	flow.nodeAccountIDs = nodeID
+	return flow
+}
+
+// GetNodeAccountIDs returns the node AccountID for the transactions of this ScheduleFlow.
+func (flow *ScheduleFlow) GetNodeAccountIDs() []AccountID {
+	return flow.nodeAccountIDs
+}
+
+func (flow *ScheduleFlow) _CreateScheduleCreateTransaction() (*ScheduleCreateTransaction, error) {
+	scheduleCreateTx, err := NewScheduleCreateTransaction().
+		SetScheduleMemo(flow.memo).
+		SetWaitForExpiry(flow.waitForExpiry).
+		SetScheduledTransaction(flow.scheduledTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	if flow.payerAccountID != nil {
+		scheduleCreateTx.SetPayerAccountID(*flow.payerAccountID)
+	}
+
+	if flow.adminKey != nil {
+		scheduleCreateTx.SetAdminKey(flow.adminKey)
+	}
+
+	if flow.expirationTime != nil {
+		scheduleCreateTx.SetExpirationTime(*flow.expirationTime)
+	}
+
+	if len(flow.nodeAccountIDs) > 0 {
+		scheduleCreateTx.SetNodeAccountIDs(flow.nodeAccountIDs)
+	}
+
+	return scheduleCreateTx, nil
+}
+
+func (flow *ScheduleFlow) _CreateScheduleSignTransaction(scheduleID ScheduleID) *ScheduleSignTransaction {
+	scheduleSignTx := NewScheduleSignTransaction().
+		SetScheduleID(scheduleID)
+
+	if len(flow.nodeAccountIDs) > 0 {
+		scheduleSignTx.SetNodeAccountIDs(flow.nodeAccountIDs)
+	}
+
+	return scheduleSignTx
+}
+
+// _CreateOrSign submits the schedule create transaction and falls back to signing the existing schedule
+// when the network reports an identical schedule.
+func (flow *ScheduleFlow) _CreateOrSign(client *Client) (ScheduleID, error) {
+	scheduleCreateTx, err := flow._CreateScheduleCreateTransaction()
+	if err != nil {
+		return ScheduleID{}, err
+	}
+	if _, err = scheduleCreateTx.FreezeWith(client); err != nil {
+		return ScheduleID{}, err
+	}
+	for _, signer := range flow.signers {
+		scheduleCreateTx.SignWith(signer.publicKey, signer.signer)
+	}
+
+	createResponse, err := scheduleCreateTx.Execute(client)
+	if err != nil {
+		return ScheduleID{}, err
+	}
+	createReceipt, err := createResponse.SetValidateStatus(false).GetReceipt(client)
+	if err != nil {
+		return ScheduleID{}, err
+	}
+
+	switch createReceipt.Status {
+	case StatusSuccess:
+	case StatusIdenticalScheduleAlreadyCreated:
+		if createReceipt.ScheduleID == nil {
+			return ScheduleID{}, errors.New("scheduleID is nil")
+		}
+		return *createReceipt.ScheduleID, flow._SignSchedule(*createReceipt.ScheduleID, client)
+	default:
+		return ScheduleID{}, ErrHederaReceiptStatus{TxID: createResponse.TransactionID, Status: createReceipt.Status, Receipt: createReceipt}
+	}
+
+	if createReceipt.ScheduleID == nil {
+		return ScheduleID{}, errors.New("scheduleID is nil")
+	}
+
+	return *createReceipt.ScheduleID, nil
+}
+
+func (flow *ScheduleFlow) _SignSchedule(scheduleID ScheduleID, client *Client) error {
+	scheduleSignTx := flow._CreateScheduleSignTransaction(scheduleID)
+	if _, err := scheduleSignTx.FreezeWith(client); err != nil {
+		return err
+	}
+	for _, signer := range flow.signers {
+		scheduleSignTx.SignWith(signer.publicKey, signer.signer)
+	}
+
+	signResponse, err := scheduleSignTx.Execute(client)
+	if err != nil {
+		var precheckErr ErrHederaPreCheckStatus
+		if errors.As(err, &precheckErr) && precheckErr.Status == StatusNoNewValidSignatures {
+			return nil
+		}
+		return err
+	}
+	signReceipt, err := signResponse.SetValidateStatus(false).GetReceipt(client)
+	if err != nil {
+		return err
+	}
+
+	switch signReceipt.Status {
+	case StatusSuccess, StatusNoNewValidSignatures, StatusScheduleAlreadyExecuted:
+		return nil
+	default:
+		return ErrHederaReceiptStatus{TxID: signResponse.TransactionID, Status: signReceipt.Status, Receipt: signReceipt}
+	}
+}
+
+// _WaitForExecution polls the schedule until it was executed, deleted or has expired, or ctx is done.
+func (flow *ScheduleFlow) _WaitForExecution(ctx context.Context, scheduleID ScheduleID, client *Client) (ScheduleInfo, error) {
+	for {
+		if err := ctx.Err(); err != nil {
+			return ScheduleInfo{}, err
+		}
+
+		info, err := NewScheduleInfoQuery().
+			SetScheduleID(scheduleID).
+			Execute(client)
+		if err != nil {
+			var precheckErr ErrHederaPreCheckStatus
+			if errors.As(err, &precheckErr) && precheckErr.Status == StatusInvalidScheduleID {
+				return ScheduleInfo{}, ErrScheduleStatus{ScheduleID: scheduleID, Status: StatusInvalidScheduleID}
+			}
+			return ScheduleInfo{}, err
+		}
+
+		var scheduledTransactionID TransactionID
+		if info.ScheduledTransactionID != nil {
+			scheduledTransactionID = *info.ScheduledTransactionID
+		}
+
+		if info.ExecutedAt != nil {
+			return info, nil
+		}
+
+		if info.DeletedAt != nil {
+			return info, ErrScheduleStatus{ScheduleID: scheduleID, TxID: scheduledTransactionID, Status: StatusScheduleAlreadyDeleted}
+		}
+
+		untilExpiration := time.Until(info.ExpirationTime)
+		if untilExpiration < -scheduleFlowExpiryGrace {
+			return info, ErrScheduleStatus{ScheduleID: scheduleID, TxID: scheduledTransactionID, Status: StatusInvalidScheduleID}
+		}
+
+		wait := flow.pollInterval
+		if info.WaitForExpiry && untilExpiration > wait {
+			wait = untilExpiration
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return info, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// _GetScheduledRecord gets the record of the executed scheduled transaction. The consensus nodes only keep receipts
+// and records for a few minutes after consensus, so the mirror node is asked when they no longer have them, eg. when
+// the schedule waited for its expiry or had already executed when the flow found it.
+func (flow *ScheduleFlow) _GetScheduledRecord(scheduleID ScheduleID, scheduledTransactionID TransactionID, client *Client) (TransactionRecord, error) {
+	receipt, err := NewTransactionReceiptQuery().
+		SetTransactionID(scheduledTransactionID).
+		Execute(client)
+	if err == nil {
+		if receipt.Status != StatusSuccess {
+			return TransactionRecord{Receipt: receipt}, ErrScheduleStatus{ScheduleID: scheduleID, TxID: scheduledTransactionID, Status: receipt.Status, Receipt: &receipt}
+		}
+
+		record, err := NewTransactionRecordQuery().
+			SetTransactionID(scheduledTransactionID).
+			Execute(client)
+		if !_IsReceiptOrRecordNotFound(err) {
+			return record, err
+		}
+	} else if !_IsReceiptOrRecordNotFound(err) {
+		return TransactionRecord{}, err
+	}
+
+	record, err := NewMirrorTransactionRecordQuery().
+		SetTransactionID(scheduledTransactionID).
+		Execute(client)
+	var receiptErr ErrHederaReceiptStatus
+	if errors.As(err, &receiptErr) {
+		return record, ErrScheduleStatus{ScheduleID: scheduleID, TxID: scheduledTransactionID, Status: receiptErr.Status, Receipt: &record.Receipt}
+	}
+
+	return record, err
+}
+
+func _IsReceiptOrRecordNotFound(err error) bool {
+	var precheckErr ErrHederaPreCheckStatus
+	return errors.As(err, &precheckErr) && (precheckErr.Status == StatusReceiptNotFound || precheckErr.Status == StatusRecordNotFound)
+}
+
+// Execute creates the schedule, or signs the identical schedule if it already exists, and waits until the scheduled
+// transaction has executed. It returns the record of the scheduled transaction, or ErrScheduleStatus when the
+// schedule was deleted, expired or the scheduled transaction failed. A schedule which waits for its expiry can take
+// up to its expiration time, use ExecuteWithContext to bound the wait.
+func (flow *ScheduleFlow) Execute(client *Client) (TransactionRecord, error) {
+	return flow.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext is like Execute, but stops waiting for the scheduled transaction and returns the error of ctx
+// once ctx is done. The schedule itself is left on the network.
+func (flow *ScheduleFlow) ExecuteWithContext(ctx context.Context, client *Client) (TransactionRecord, error) {
+	if flow.scheduledTransaction == nil {
+		return TransactionRecord{}, errScheduleFlowNoTransaction
+	}
+
+	scheduleID, err := flow._CreateOrSign(client)
+	if err != nil {
+		return TransactionRecord{}, err
+	}
+
+	info, err := flow._WaitForExecution(ctx, scheduleID, client)
+	if err != nil {
+		return TransactionRecord{}, err
+	}
+	if info.ScheduledTransactionID == nil {
+		return TransactionRecord{}, errors.New("scheduledTransactionID is nil")
+	}
+
+	return flow._GetScheduledRecord(scheduleID, *info.ScheduledTransactionID, client)
+}
@@ -0,0 +1,267 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+func _ScheduleFlowReceiptResponse(status services.ResponseCodeEnum, scheduleID *ScheduleID) *services.Response {
+	receipt := &services.TransactionReceipt{Status: status}
+	if scheduleID != nil {
+		receipt.ScheduleID = scheduleID._ToProtobuf()
+	}
+
+	return &services.Response{
+		Response: &services.Response_TransactionGetReceipt{
+			TransactionGetReceipt: &services.TransactionGetReceiptResponse{
+				Header:  &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_ANSWER_ONLY},
+				Receipt: receipt,
+			},
+		},
+	}
+}
+
+func _ScheduleFlowInfoResponses(info *services.ScheduleInfo) []interface{} {
+	return []interface{}{
+		&services.Response{
+			Response: &services.Response_ScheduleGetInfo{
+				ScheduleGetInfo: &services.ScheduleGetInfoResponse{
+					Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_COST_ANSWER},
+				},
+			},
+		},
+		&services.Response{
+			Response: &services.Response_ScheduleGetInfo{
+				ScheduleGetInfo: &services.ScheduleGetInfoResponse{
+					Header:       &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_ANSWER_ONLY},
+					ScheduleInfo: info,
+				},
+			},
+		},
+	}
+}
+
+func TestUnitScheduleFlowSignsIdenticalSchedule(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	scheduleID := ScheduleID{Schedule: 1234}
+	scheduledTransactionID := testTransactionID.SetScheduled(true)
+	pending := &services.ScheduleInfo{
+		ScheduleID:             scheduleID._ToProtobuf(),
+		ExpirationTime:         _TimeToProtobuf(time.Now().Add(time.Hour)),
+		ScheduledTransactionID: scheduledTransactionID._ToProtobuf(),
+	}
+	executed := &services.ScheduleInfo{
+		ScheduleID:             scheduleID._ToProtobuf(),
+		ExpirationTime:         _TimeToProtobuf(time.Now().Add(time.Hour)),
+		ScheduledTransactionID: scheduledTransactionID._ToProtobuf(),
+		Data:                   &services.ScheduleInfo_ExecutionTime{ExecutionTime: _TimeToProtobuf(time.Now())},
+	}
+
+	var signedTransactions []*services.Transaction
+	submit := func(request *services.Transaction) *services.TransactionResponse {
+		signedTransactions = append(signedTransactions, request)
+		return &services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK}
+	}
+
+	responses := []interface{}{
+		submit,
+		_ScheduleFlowReceiptResponse(services.ResponseCodeEnum_IDENTICAL_SCHEDULE_ALREADY_CREATED, &scheduleID),
+		submit,
+		_ScheduleFlowReceiptResponse(services.ResponseCodeEnum_SUCCESS, nil),
+	}
+	responses = append(responses, _ScheduleFlowInfoResponses(pending)...)
+	responses = append(responses, _ScheduleFlowInfoResponses(executed)...)
+	responses = append(responses,
+		_ScheduleFlowReceiptResponse(services.ResponseCodeEnum_SUCCESS, nil),
+		&services.Response{
+			Response: &services.Response_TransactionGetRecord{
+				TransactionGetRecord: &services.TransactionGetRecordResponse{
+					Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_COST_ANSWER},
+				},
+			},
+		},
+		&services.Response{
+			Response: &services.Response_TransactionGetRecord{
+				TransactionGetRecord: &services.TransactionGetRecordResponse{
+					Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_ANSWER_ONLY},
+					TransactionRecord: &services.TransactionRecord{
+						Receipt:       &services.TransactionReceipt{Status: services.ResponseCodeEnum_SUCCESS},
+						TransactionID: scheduledTransactionID._ToProtobuf(),
+						Memo:          "scheduled",
+					},
+				},
+			},
+		},
+	)
+
+	client, server := NewMockClientAndServer([][]interface{}{responses})
+	defer server.Close()
+
+	record, err := NewScheduleFlow().
+		SetScheduledTransaction(NewTransferTransaction().
+			AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-1)).
+			AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(1))).
+		Sign(key).
+		SetPollInterval(time.Millisecond).
+		Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, "scheduled", record.TransactionMemo)
+	assert.True(t, record.TransactionID.GetScheduled())
+
+	require.Len(t, signedTransactions, 2)
+	for _, transaction := range signedTransactions {
+		signedTx := services.SignedTransaction{}
+		require.NoError(t, protobuf.Unmarshal(transaction.SignedTransactionBytes, &signedTx))
+		assert.Len(t, signedTx.SigMap.SigPair, 2)
+	}
+}
+
+func TestUnitScheduleFlowDeletedSchedule(t *testing.T) {
+	t.Parallel()
+
+	scheduleID := ScheduleID{Schedule: 1234}
+	responses := []interface{}{
+		&services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK},
+		_ScheduleFlowReceiptResponse(services.ResponseCodeEnum_SUCCESS, &scheduleID),
+	}
+	responses = append(responses, _ScheduleFlowInfoResponses(&services.ScheduleInfo{
+		ScheduleID:     scheduleID._ToProtobuf(),
+		ExpirationTime: _TimeToProtobuf(time.Now().Add(time.Hour)),
+		Data:           &services.ScheduleInfo_DeletionTime{DeletionTime: _TimeToProtobuf(time.Now())},
+	})...)
+
+	client, server := NewMockClientAndServer([][]interface{}{responses})
+	defer server.Close()
+
+	_, err := NewScheduleFlow().
+		SetScheduledTransaction(NewTransferTransaction().
+			AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-1)).
+			AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(1))).
+		SetPollInterval(time.Millisecond).
+		Execute(client)
+
+	var scheduleErr ErrScheduleStatus
+	require.ErrorAs(t, err, &scheduleErr)
+	assert.Equal(t, scheduleID, scheduleErr.ScheduleID)
+	assert.Equal(t, StatusScheduleAlreadyDeleted, scheduleErr.Status)
+}
+
+func TestUnitScheduleFlowRequiresTransaction(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewScheduleFlow().Execute(nil)
+	require.ErrorIs(t, err, errScheduleFlowNoTransaction)
+}
+
+func TestUnitScheduleFlowFallsBackToMirrorRecord(t *testing.T) {
+	t.Parallel()
+
+	scheduleID := ScheduleID{Schedule: 1234}
+	scheduledTransactionID := testTransactionID.SetScheduled(true)
+	responses := []interface{}{
+		&services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK},
+		_ScheduleFlowReceiptResponse(services.ResponseCodeEnum_IDENTICAL_SCHEDULE_ALREADY_CREATED, &scheduleID),
+		&services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK},
+		_ScheduleFlowReceiptResponse(services.ResponseCodeEnum_SCHEDULE_ALREADY_EXECUTED, nil),
+	}
+	// The schedule executed long ago, so the nodes no longer have the receipt
+	responses = append(responses, _ScheduleFlowInfoResponses(&services.ScheduleInfo{
+		ScheduleID:             scheduleID._ToProtobuf(),
+		ExpirationTime:         _TimeToProtobuf(time.Now().Add(-time.Hour)),
+		ScheduledTransactionID: scheduledTransactionID._ToProtobuf(),
+		Data:                   &services.ScheduleInfo_ExecutionTime{ExecutionTime: _TimeToProtobuf(time.Now().Add(-2 * time.Hour))},
+	})...)
+	responses = append(responses, &services.Response{
+		Response: &services.Response_TransactionGetReceipt{
+			TransactionGetReceipt: &services.TransactionGetReceiptResponse{
+				Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_RECEIPT_NOT_FOUND, ResponseType: services.ResponseType_ANSWER_ONLY},
+			},
+		},
+	})
+
+	client, server := NewMockClientAndServer([][]interface{}{responses})
+	defer server.Close()
+	client.SetMaxAttempts(1)
+
+	var mirrorPath string
+	mirrorServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mirrorPath = r.URL.Path
+		assert.Equal(t, "true", r.URL.Query().Get("scheduled"))
+		_, _ = w.Write([]byte(`{"transactions":[{"consensus_timestamp":"1700000005.000000002","name":"CRYPTOTRANSFER",
+			"nonce":0,"result":"SUCCESS","scheduled":true,"transaction_hash":"","memo_base64":"c2NoZWR1bGVk",
+			"transaction_id":"` + _TransactionIDToMirrorString(scheduledTransactionID) + `"}]}`))
+	}))
+	defer mirrorServer.Close()
+	target, err := url.Parse(mirrorServer.URL)
+	require.NoError(t, err)
+	client.SetMirrorNetwork([]string{"mirror.example.com:443"})
+	client.SetMirrorHTTPClient(&http.Client{
+		Transport: _MirrorRoundTripper(func(request *http.Request) (*http.Response, error) {
+			request.URL.Scheme = target.Scheme
+			request.URL.Host = target.Host
+			return http.DefaultTransport.RoundTrip(request)
+		}),
+	})
+
+	record, err := NewScheduleFlow().
+		SetScheduledTransaction(NewTransferTransaction().
+			AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-1)).
+			AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(1))).
+		SetPollInterval(time.Millisecond).
+		Execute(client)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(mirrorPath, "/api/v1/transactions/"))
+	assert.Equal(t, "scheduled", record.TransactionMemo)
+	assert.True(t, record.TransactionID.GetScheduled())
+}
+
+func TestUnitScheduleFlowExecuteWithContext(t *testing.T) {
+	t.Parallel()
+
+	scheduleID := ScheduleID{Schedule: 1234}
+	responses := []interface{}{
+		&services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK},
+		_ScheduleFlowReceiptResponse(services.ResponseCodeEnum_SUCCESS, &scheduleID),
+	}
+	// The schedule waits for an expiry which is months away
+	responses = append(responses, _ScheduleFlowInfoResponses(&services.ScheduleInfo{
+		ScheduleID:     scheduleID._ToProtobuf(),
+		ExpirationTime: _TimeToProtobuf(time.Now().Add(60 * 24 * time.Hour)),
+		WaitForExpiry:  true,
+	})...)
+
+	client, server := NewMockClientAndServer([][]interface{}{responses})
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := NewScheduleFlow().
+		SetScheduledTransaction(NewTransferTransaction().
+			AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-1)).
+			AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(1))).
+		SetWaitForExpiry(true).
+		ExecuteWithContext(ctx, client)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 10*time.Second)
+}
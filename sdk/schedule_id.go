@@ -102,6 +102,11 @@ func (id ScheduleID) _ToProtobuf() *services.ScheduleID {
 	}
 }
 
+// MarshalJSON implements the encoding.JSON interface.
+func (id ScheduleID) MarshalJSON() ([]byte, error) {
+	return _StringToJSON(id.String())
+}
+
 // UnmarshalJSON implements the encoding.JSON interface.
 func (id *ScheduleID) UnmarshalJSON(data []byte) error {
 	scheduleID, err := ScheduleIDFromString(strings.Replace(string(data), "\"", "", 2))
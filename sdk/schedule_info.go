@@ -111,19 +111,22 @@ func (scheduleInfo *ScheduleInfo) _ToProtobuf() *services.ScheduleInfo { // noli
 		Signers:                  signatories,
 		CreatorAccountID:         scheduleInfo.CreatorAccountID._ToProtobuf(),
 		PayerAccountID:           scheduleInfo.PayerAccountID._ToProtobuf(),
-		ScheduledTransactionID:   scheduleInfo.ScheduledTransactionID._ToProtobuf(),
 		LedgerId:                 scheduleInfo.LedgerID.ToBytes(),
 		WaitForExpiry:            scheduleInfo.WaitForExpiry,
 	}
 
+	if scheduleInfo.ScheduledTransactionID != nil {
+		info.ScheduledTransactionID = scheduleInfo.ScheduledTransactionID._ToProtobuf()
+	}
+
 	if scheduleInfo.ExecutedAt != nil {
-		info.Data = &services.ScheduleInfo_DeletionTime{
-			DeletionTime: _TimeToProtobuf(*scheduleInfo.DeletedAt),
-		}
-	} else if scheduleInfo.DeletedAt != nil {
 		info.Data = &services.ScheduleInfo_ExecutionTime{
 			ExecutionTime: _TimeToProtobuf(*scheduleInfo.ExecutedAt),
 		}
+	} else if scheduleInfo.DeletedAt != nil {
+		info.Data = &services.ScheduleInfo_DeletionTime{
+			DeletionTime: _TimeToProtobuf(*scheduleInfo.DeletedAt),
+		}
 	}
 
 	return info
@@ -134,3 +137,20 @@ func (scheduleInfo *ScheduleInfo) GetScheduledTransaction() (TransactionInterfac
 	pb := scheduleInfo.scheduledTransactionBody
 	return transactionFromScheduledTransaction(pb)
 }
+
+// MarshalJSON returns the protobuf JSON mapping of the proto.ScheduleInfo message for the ScheduleInfo.
+func (scheduleInfo ScheduleInfo) MarshalJSON() ([]byte, error) {
+	return _ProtobufToJSON(scheduleInfo._ToProtobuf())
+}
+
+// UnmarshalJSON decodes a ScheduleInfo from the protobuf JSON mapping of the proto.ScheduleInfo message.
+func (scheduleInfo *ScheduleInfo) UnmarshalJSON(data []byte) error {
+	pb := services.ScheduleInfo{}
+	if err := _ProtobufFromJSON(data, &pb); err != nil {
+		return err
+	}
+
+	*scheduleInfo = _ScheduleInfoFromProtobuf(&pb)
+
+	return nil
+}
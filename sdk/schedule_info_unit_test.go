@@ -0,0 +1,53 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitScheduleInfoExecutionAndDeletionTime(t *testing.T) {
+	t.Parallel()
+
+	at := time.Unix(1700000000, 5)
+
+	executed := (&ScheduleInfo{ScheduleID: ScheduleID{Schedule: 3}, ExecutedAt: &at})._ToProtobuf()
+	require.IsType(t, &services.ScheduleInfo_ExecutionTime{}, executed.Data)
+	assert.Equal(t, at, _TimeFromProtobuf(executed.GetExecutionTime()))
+
+	info := _ScheduleInfoFromProtobuf(executed)
+	require.NotNil(t, info.ExecutedAt)
+	assert.Equal(t, at, *info.ExecutedAt)
+	assert.Nil(t, info.DeletedAt)
+
+	deleted := (&ScheduleInfo{ScheduleID: ScheduleID{Schedule: 3}, DeletedAt: &at})._ToProtobuf()
+	require.IsType(t, &services.ScheduleInfo_DeletionTime{}, deleted.Data)
+	assert.Equal(t, at, _TimeFromProtobuf(deleted.GetDeletionTime()))
+
+	info = _ScheduleInfoFromProtobuf(deleted)
+	require.NotNil(t, info.DeletedAt)
+	assert.Equal(t, at, *info.DeletedAt)
+	assert.Nil(t, info.ExecutedAt)
+}
+
+func TestUnitScheduleInfoWithoutScheduledTransactionID(t *testing.T) {
+	t.Parallel()
+
+	var pb *services.ScheduleInfo
+	require.NotPanics(t, func() {
+		pb = (&ScheduleInfo{ScheduleID: ScheduleID{Schedule: 3}})._ToProtobuf()
+	})
+	assert.Nil(t, pb.ScheduledTransactionID)
+
+	transactionID := testTransactionID
+	pb = (&ScheduleInfo{ScheduleID: ScheduleID{Schedule: 3}, ScheduledTransactionID: &transactionID})._ToProtobuf()
+	assert.Equal(t, testTransactionID.String(), _TransactionIDFromProtobuf(pb.ScheduledTransactionID).String())
+}
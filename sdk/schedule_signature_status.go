@@ -0,0 +1,404 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"fmt"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+)
+
+// ScheduleSignatureRequirement is a key the scheduled transaction needs a signature from.
+type ScheduleSignatureRequirement struct {
+	// Description names the role of the key, eg. "payer 0.0.2" or "supply key of token 0.0.5"
+	Description string
+	Key         Key
+	Satisfied   bool
+	// MissingKeys are the primitive keys of Key which have not signed yet
+	MissingKeys []PublicKey
+}
+
+// ScheduleSignatureStatus compares the keys required by a scheduled transaction against the signatories of the schedule.
+type ScheduleSignatureStatus struct {
+	Requirements []ScheduleSignatureRequirement
+	// SatisfiedKeys are the signatories that count towards at least one requirement
+	SatisfiedKeys []PublicKey
+	// MissingKeys are the primitive keys of all unsatisfied requirements which have not signed yet
+	MissingKeys []PublicKey
+	// Complete is false when the SDK does not know all the keys the type of the scheduled transaction requires,
+	// in which case only the payer and the known keys are listed.
+	Complete bool
+	// Satisfied is true when every requirement has enough signatures
+	Satisfied bool
+	// ExecutesOnNextSignature is true when a signature from any single key of NextSignatureKeys satisfies
+	// every requirement
+	ExecutesOnNextSignature bool
+	NextSignatureKeys       []PublicKey
+}
+
+// SignatureStatus computes the keys required by the scheduled transaction, ie. the payer, the senders of a transfer
+// and the token, topic and account keys of the operation, and reports which of them are satisfied by the signatories
+// of the schedule. Keys which are not known locally are queried from the network with the given client.
+func (scheduleInfo *ScheduleInfo) SignatureStatus(client *Client) (ScheduleSignatureStatus, error) {
+	resolver := _ScheduleKeyResolver{
+		client:   client,
+		accounts: map[AccountID]AccountInfo{},
+		tokens:   map[TokenID]TokenInfo{},
+	}
+
+	payerAccountID := scheduleInfo.PayerAccountID
+	if payerAccountID._IsZero() {
+		payerAccountID = scheduleInfo.CreatorAccountID
+	}
+
+	if err := resolver._AddAccount("payer", payerAccountID); err != nil {
+		return ScheduleSignatureStatus{}, err
+	}
+
+	complete, err := resolver._AddScheduledTransaction(scheduleInfo.scheduledTransactionBody)
+	if err != nil {
+		return ScheduleSignatureStatus{}, err
+	}
+
+	var signatories []Key
+	if scheduleInfo.Signatories != nil {
+		signatories = scheduleInfo.Signatories.GetKeys()
+	}
+
+	status := _EvaluateScheduleSignatures(resolver.requirements, signatories)
+	status.Complete = complete
+
+	return status, nil
+}
+
+type _ScheduleKeyResolver struct {
+	client       *Client
+	accounts     map[AccountID]AccountInfo
+	tokens       map[TokenID]TokenInfo
+	requirements []ScheduleSignatureRequirement
+}
+
+func (resolver *_ScheduleKeyResolver) _Add(description string, key Key) {
+	if key == nil {
+		return
+	}
+
+	resolver.requirements = append(resolver.requirements, ScheduleSignatureRequirement{
+		Description: description,
+		Key:         key,
+	})
+}
+
+func (resolver *_ScheduleKeyResolver) _AccountInfo(accountID AccountID) (AccountInfo, error) {
+	if info, ok := resolver.accounts[accountID]; ok {
+		return info, nil
+	}
+
+	info, err := NewAccountInfoQuery().
+		SetAccountID(accountID).
+		Execute(resolver.client)
+	if err != nil {
+		return AccountInfo{}, err
+	}
+
+	resolver.accounts[accountID] = info
+	return info, nil
+}
+
+func (resolver *_ScheduleKeyResolver) _AddAccount(role string, accountID AccountID) error {
+	info, err := resolver._AccountInfo(accountID)
+	if err != nil {
+		return err
+	}
+
+	resolver._Add(fmt.Sprintf("%s %s", role, accountID.String()), info.Key)
+	return nil
+}
+
+func (resolver *_ScheduleKeyResolver) _AddReceiver(accountID AccountID) error {
+	info, err := resolver._AccountInfo(accountID)
+	if err != nil {
+		return err
+	}
+
+	if info.ReceiverSigRequired {
+		resolver._Add(fmt.Sprintf("receiver %s", accountID.String()), info.Key)
+	}
+
+	return nil
+}
+
+func (resolver *_ScheduleKeyResolver) _AddTokenKey(role string, pb *services.TokenID, key func(TokenInfo) Key) error {
+	if pb == nil {
+		return nil
+	}
+	tokenID := *_TokenIDFromProtobuf(pb)
+
+	info, ok := resolver.tokens[tokenID]
+	if !ok {
+		var err error
+		info, err = NewTokenInfoQuery().
+			SetTokenID(tokenID).
+			Execute(resolver.client)
+		if err != nil {
+			return err
+		}
+		resolver.tokens[tokenID] = info
+	}
+
+	resolver._Add(fmt.Sprintf("%s of token %s", role, tokenID.String()), key(info))
+	return nil
+}
+
+func (resolver *_ScheduleKeyResolver) _AddTransfers(pb *services.CryptoTransferTransactionBody) error {
+	amounts := pb.GetTransfers().GetAccountAmounts()
+	for _, tokenTransfers := range pb.GetTokenTransfers() {
+		amounts = append(amounts, tokenTransfers.GetTransfers()...)
+	}
+
+	seen := map[AccountID]bool{}
+	add := func(pb *services.AccountID, sender bool) error {
+		if pb == nil {
+			return nil
+		}
+		accountID := *_AccountIDFromProtobuf(pb)
+		if seen[accountID] {
+			return nil
+		}
+		seen[accountID] = true
+
+		if sender {
+			return resolver._AddAccount("sender", accountID)
+		}
+		return resolver._AddReceiver(accountID)
+	}
+
+	// Senders are added first so an account which both sends and receives is listed as a sender
+	for _, amount := range amounts {
+		if amount.GetAmount() < 0 && !amount.GetIsApproval() {
+			if err := add(amount.GetAccountID(), true); err != nil {
+				return err
+			}
+		}
+	}
+	for _, tokenTransfers := range pb.GetTokenTransfers() {
+		for _, nftTransfer := range tokenTransfers.GetNftTransfers() {
+			if !nftTransfer.GetIsApproval() {
+				if err := add(nftTransfer.GetSenderAccountID(), true); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	for _, amount := range amounts {
+		if amount.GetAmount() > 0 {
+			if err := add(amount.GetAccountID(), false); err != nil {
+				return err
+			}
+		}
+	}
+	for _, tokenTransfers := range pb.GetTokenTransfers() {
+		for _, nftTransfer := range tokenTransfers.GetNftTransfers() {
+			if err := add(nftTransfer.GetReceiverAccountID(), false); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// _AddScheduledTransaction adds the keys required by the scheduled transaction body and returns whether the
+// type of the body is known.
+func (resolver *_ScheduleKeyResolver) _AddScheduledTransaction(pb *services.SchedulableTransactionBody) (bool, error) {
+	switch data := pb.GetData().(type) {
+	case *services.SchedulableTransactionBody_CryptoTransfer:
+		return true, resolver._AddTransfers(data.CryptoTransfer)
+	case *services.SchedulableTransactionBody_CryptoUpdateAccount:
+		if data.CryptoUpdateAccount.GetAccountIDToUpdate() != nil {
+			if err := resolver._AddAccount("account", *_AccountIDFromProtobuf(data.CryptoUpdateAccount.GetAccountIDToUpdate())); err != nil {
+				return true, err
+			}
+		}
+		if data.CryptoUpdateAccount.GetKey() != nil {
+			key, err := _KeyFromProtobuf(data.CryptoUpdateAccount.GetKey())
+			if err != nil {
+				return true, err
+			}
+			resolver._Add("new account key", key)
+		}
+		return true, nil
+	case *services.SchedulableTransactionBody_CryptoDelete:
+		if data.CryptoDelete.GetDeleteAccountID() == nil {
+			return true, nil
+		}
+		return true, resolver._AddAccount("account", *_AccountIDFromProtobuf(data.CryptoDelete.GetDeleteAccountID()))
+	case *services.SchedulableTransactionBody_TokenAssociate:
+		if data.TokenAssociate.GetAccount() == nil {
+			return true, nil
+		}
+		return true, resolver._AddAccount("account", *_AccountIDFromProtobuf(data.TokenAssociate.GetAccount()))
+	case *services.SchedulableTransactionBody_TokenDissociate:
+		if data.TokenDissociate.GetAccount() == nil {
+			return true, nil
+		}
+		return true, resolver._AddAccount("account", *_AccountIDFromProtobuf(data.TokenDissociate.GetAccount()))
+	case *services.SchedulableTransactionBody_TokenMint:
+		return true, resolver._AddTokenKey("supply key", data.TokenMint.GetToken(), func(info TokenInfo) Key { return info.SupplyKey })
+	case *services.SchedulableTransactionBody_TokenBurn:
+		return true, resolver._AddTokenKey("supply key", data.TokenBurn.GetToken(), func(info TokenInfo) Key { return info.SupplyKey })
+	case *services.SchedulableTransactionBody_TokenWipe:
+		return true, resolver._AddTokenKey("wipe key", data.TokenWipe.GetToken(), func(info TokenInfo) Key { return info.WipeKey })
+	case *services.SchedulableTransactionBody_TokenFreeze:
+		return true, resolver._AddTokenKey("freeze key", data.TokenFreeze.GetToken(), func(info TokenInfo) Key { return info.FreezeKey })
+	case *services.SchedulableTransactionBody_TokenUnfreeze:
+		return true, resolver._AddTokenKey("freeze key", data.TokenUnfreeze.GetToken(), func(info TokenInfo) Key { return info.FreezeKey })
+	case *services.SchedulableTransactionBody_TokenGrantKyc:
+		return true, resolver._AddTokenKey("kyc key", data.TokenGrantKyc.GetToken(), func(info TokenInfo) Key { return info.KycKey })
+	case *services.SchedulableTransactionBody_TokenRevokeKyc:
+		return true, resolver._AddTokenKey("kyc key", data.TokenRevokeKyc.GetToken(), func(info TokenInfo) Key { return info.KycKey })
+	case *services.SchedulableTransactionBody_TokenPause:
+		return true, resolver._AddTokenKey("pause key", data.TokenPause.GetToken(), func(info TokenInfo) Key { return info.PauseKey })
+	case *services.SchedulableTransactionBody_TokenUnpause:
+		return true, resolver._AddTokenKey("pause key", data.TokenUnpause.GetToken(), func(info TokenInfo) Key { return info.PauseKey })
+	case *services.SchedulableTransactionBody_TokenDeletion:
+		return true, resolver._AddTokenKey("admin key", data.TokenDeletion.GetToken(), func(info TokenInfo) Key { return info.AdminKey })
+	case *services.SchedulableTransactionBody_TokenFeeScheduleUpdate:
+		return true, resolver._AddTokenKey("fee schedule key", data.TokenFeeScheduleUpdate.GetTokenId(), func(info TokenInfo) Key { return info.FeeScheduleKey })
+	case *services.SchedulableTransactionBody_ConsensusSubmitMessage:
+		if data.ConsensusSubmitMessage.GetTopicID() == nil {
+			return true, nil
+		}
+		topicID := *_TopicIDFromProtobuf(data.ConsensusSubmitMessage.GetTopicID())
+		info, err := NewTopicInfoQuery().
+			SetTopicID(topicID).
+			Execute(resolver.client)
+		if err != nil {
+			return true, err
+		}
+		resolver._Add(fmt.Sprintf("submit key of topic %s", topicID.String()), info.SubmitKey)
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+func _EvaluateScheduleSignatures(requirements []ScheduleSignatureRequirement, signatories []Key) ScheduleSignatureStatus {
+	signed := map[string]bool{}
+	for _, key := range signatories {
+		if publicKey, ok := key.(PublicKey); ok {
+			signed[publicKey.String()] = true
+		}
+	}
+
+	status := ScheduleSignatureStatus{
+		Requirements: requirements,
+		Satisfied:    true,
+	}
+
+	satisfiedKeys := map[string]bool{}
+	missingKeys := map[string]bool{}
+	for i := range status.Requirements {
+		requirement := &status.Requirements[i]
+		requirement.Satisfied = _KeySatisfied(requirement.Key, signed)
+		requirement.MissingKeys = nil
+
+		for _, publicKey := range _PrimitiveKeys(requirement.Key) {
+			if signed[publicKey.String()] {
+				if !satisfiedKeys[publicKey.String()] {
+					satisfiedKeys[publicKey.String()] = true
+					status.SatisfiedKeys = append(status.SatisfiedKeys, publicKey)
+				}
+				continue
+			}
+
+			if requirement.Satisfied {
+				continue
+			}
+			requirement.MissingKeys = append(requirement.MissingKeys, publicKey)
+			if !missingKeys[publicKey.String()] {
+				missingKeys[publicKey.String()] = true
+				status.MissingKeys = append(status.MissingKeys, publicKey)
+			}
+		}
+
+		status.Satisfied = status.Satisfied && requirement.Satisfied
+	}
+
+	if status.Satisfied {
+		return status
+	}
+
+	for _, candidate := range status.MissingKeys {
+		signed[candidate.String()] = true
+
+		satisfied := true
+		for _, requirement := range status.Requirements {
+			if !_KeySatisfied(requirement.Key, signed) {
+				satisfied = false
+				break
+			}
+		}
+		if satisfied {
+			status.NextSignatureKeys = append(status.NextSignatureKeys, candidate)
+		}
+
+		delete(signed, candidate.String())
+	}
+	status.ExecutesOnNextSignature = len(status.NextSignatureKeys) > 0
+
+	return status
+}
+
+// _KeySatisfied reports whether the signed public keys satisfy the key. Key lists without a threshold
+// require every key, contract keys can't be satisfied by signatures.
+func _KeySatisfied(key Key, signed map[string]bool) bool {
+	switch k := key.(type) {
+	case PublicKey:
+		return signed[k.String()]
+	case PrivateKey:
+		return signed[k.PublicKey().String()]
+	case KeyList:
+		return _KeyListSatisfied(&k, signed)
+	case *KeyList:
+		return _KeyListSatisfied(k, signed)
+	default:
+		return false
+	}
+}
+
+func _KeyListSatisfied(keyList *KeyList, signed map[string]bool) bool {
+	threshold := keyList.threshold
+	if threshold <= 0 {
+		threshold = len(keyList.keys)
+	}
+
+	count := 0
+	for _, key := range keyList.keys {
+		if _KeySatisfied(key, signed) {
+			count++
+		}
+	}
+
+	return count >= threshold
+}
+
+func _PrimitiveKeys(key Key) []PublicKey {
+	switch k := key.(type) {
+	case PublicKey:
+		return []PublicKey{k}
+	case PrivateKey:
+		return []PublicKey{k.PublicKey()}
+	case KeyList:
+		return _PrimitiveKeys(&k)
+	case *KeyList:
+		keys := make([]PublicKey, 0, len(k.keys))
+		for _, key := range k.keys {
+			keys = append(keys, _PrimitiveKeys(key)...)
+		}
+		return keys
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,117 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"testing"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _GenerateScheduleSignatureKeys(t *testing.T, count int) []PublicKey {
+	keys := make([]PublicKey, 0, count)
+	for i := 0; i < count; i++ {
+		key, err := PrivateKeyGenerateEd25519()
+		require.NoError(t, err)
+		keys = append(keys, key.PublicKey())
+	}
+
+	return keys
+}
+
+func _ScheduleSignatureAccountInfoResponses(accountID AccountID, key Key, receiverSigRequired bool) []interface{} {
+	return []interface{}{
+		&services.Response{
+			Response: &services.Response_CryptoGetInfo{
+				CryptoGetInfo: &services.CryptoGetInfoResponse{
+					Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_COST_ANSWER},
+				},
+			},
+		},
+		&services.Response{
+			Response: &services.Response_CryptoGetInfo{
+				CryptoGetInfo: &services.CryptoGetInfoResponse{
+					Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_ANSWER_ONLY},
+					AccountInfo: &services.CryptoGetInfoResponse_AccountInfo{
+						AccountID:           accountID._ToProtobuf(),
+						Key:                 key._ToProtoKey(),
+						ReceiverSigRequired: receiverSigRequired,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestUnitScheduleSignatureStatusThreshold(t *testing.T) {
+	t.Parallel()
+
+	keys := _GenerateScheduleSignatureKeys(t, 4)
+	threshold := KeyListWithThreshold(2).AddAllPublicKeys(keys[1:])
+	requirements := []ScheduleSignatureRequirement{
+		{Description: "payer 0.0.2", Key: keys[0]},
+		{Description: "sender 0.0.3", Key: threshold},
+	}
+
+	status := _EvaluateScheduleSignatures(requirements, []Key{keys[0]})
+	assert.False(t, status.Satisfied)
+	assert.True(t, status.Requirements[0].Satisfied)
+	assert.False(t, status.Requirements[1].Satisfied)
+	assert.Equal(t, keys[1:], status.MissingKeys)
+	assert.False(t, status.ExecutesOnNextSignature)
+
+	status = _EvaluateScheduleSignatures(requirements, []Key{keys[0], keys[2]})
+	assert.False(t, status.Satisfied)
+	assert.Equal(t, []PublicKey{keys[0], keys[2]}, status.SatisfiedKeys)
+	assert.Equal(t, []PublicKey{keys[1], keys[3]}, status.Requirements[1].MissingKeys)
+	assert.True(t, status.ExecutesOnNextSignature)
+	assert.Equal(t, []PublicKey{keys[1], keys[3]}, status.NextSignatureKeys)
+
+	status = _EvaluateScheduleSignatures(requirements, []Key{keys[0], keys[2], keys[3]})
+	assert.True(t, status.Satisfied)
+	assert.Empty(t, status.MissingKeys)
+	assert.False(t, status.ExecutesOnNextSignature)
+}
+
+func TestUnitScheduleSignatureStatusTransfer(t *testing.T) {
+	t.Parallel()
+
+	keys := _GenerateScheduleSignatureKeys(t, 3)
+	payer := AccountID{Account: 2}
+	sender := AccountID{Account: 1001}
+	receiver := AccountID{Account: 1002}
+
+	scheduled, err := NewTransferTransaction().
+		AddHbarTransfer(sender, HbarFromTinybar(-10)).
+		AddHbarTransfer(receiver, HbarFromTinybar(10)).
+		constructScheduleProtobuf()
+	require.NoError(t, err)
+
+	responses := _ScheduleSignatureAccountInfoResponses(payer, keys[0], false)
+	responses = append(responses, _ScheduleSignatureAccountInfoResponses(sender, keys[1], false)...)
+	responses = append(responses, _ScheduleSignatureAccountInfoResponses(receiver, keys[2], true)...)
+
+	client, server := NewMockClientAndServer([][]interface{}{responses})
+	defer server.Close()
+
+	info := ScheduleInfo{
+		PayerAccountID:           payer,
+		Signatories:              NewKeyList().Add(keys[0]).Add(keys[2]),
+		scheduledTransactionBody: scheduled,
+	}
+
+	status, err := info.SignatureStatus(client)
+	require.NoError(t, err)
+	assert.True(t, status.Complete)
+	require.Len(t, status.Requirements, 3)
+	assert.Equal(t, "payer 0.0.2", status.Requirements[0].Description)
+	assert.Equal(t, "sender 0.0.1001", status.Requirements[1].Description)
+	assert.Equal(t, "receiver 0.0.1002", status.Requirements[2].Description)
+	assert.Equal(t, []PublicKey{keys[1]}, status.MissingKeys)
+	assert.True(t, status.ExecutesOnNextSignature)
+}
@@ -346,6 +346,10 @@ func (tx TokenAirdropTransaction) getName() string {
 	return "TokenAirdropTransaction"
 }
 
+func (tx TokenAirdropTransaction) validateBody() []ErrTransactionValidation {
+	return _ValidateTokenTransfers(tx.tokenTransfers, tx.nftTransfers)
+}
+
 func (tx TokenAirdropTransaction) validateNetworkOnIDs(client *Client) error {
 	if client == nil || !client.autoValidateChecksums {
 		return nil
@@ -513,6 +513,24 @@ func (tx TokenCreateTransaction) getName() string {
 	return "TokenCreateTransaction"
 }
 
+func (tx TokenCreateTransaction) validateBody() []ErrTransactionValidation {
+	violations := _ValidateMemo("token memo", tx.memo)
+	if tx.tokenName == "" {
+		violations = append(violations, _NewErrTransactionValidation(StatusMissingTokenName, "token name is not set"))
+	} else if len(tx.tokenName) > maxTokenNameBytes {
+		violations = append(violations, _NewErrTransactionValidation(StatusTokenNameTooLong,
+			"token name is %d bytes, the limit is %d bytes", len(tx.tokenName), maxTokenNameBytes))
+	}
+	if tx.tokenSymbol == "" {
+		violations = append(violations, _NewErrTransactionValidation(StatusMissingTokenSymbol, "token symbol is not set"))
+	} else if len(tx.tokenSymbol) > maxTokenSymbolBytes {
+		violations = append(violations, _NewErrTransactionValidation(StatusTokenSymbolTooLong,
+			"token symbol is %d bytes, the limit is %d bytes", len(tx.tokenSymbol), maxTokenSymbolBytes))
+	}
+
+	return violations
+}
+
 func (tx TokenCreateTransaction) validateNetworkOnIDs(client *Client) error {
 	if client == nil || !client.autoValidateChecksums {
 		return nil
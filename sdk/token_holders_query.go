@@ -0,0 +1,143 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/sdk/mirror"
+	"github.com/pkg/errors"
+)
+
+// TokenHolder is the balance of a token held by an account.
+type TokenHolder struct {
+	AccountID AccountID
+	TokenID   TokenID
+	// Balance is in the smallest denomination of the token
+	Balance  uint64
+	Decimals uint64
+}
+
+// String returns the balance in whole tokens, formatted with the decimals of the token, eg. "123.45".
+func (holder TokenHolder) String() string {
+	return _FormatWithDecimals(int64(holder.Balance), uint32(holder.Decimals))
+}
+
+// TokenHoldersQuery gets the accounts holding a token and their balance from the mirror node, eg. to take a
+// snapshot of the holders for an airdrop. Like AccountBalanceAtQuery, historical balances are the ones of the
+// latest balance snapshot at or before the timestamp.
+type TokenHoldersQuery struct {
+	tokenID    *TokenID
+	timestamp  *time.Time
+	minBalance *uint64
+	pageSize   int
+}
+
+// NewTokenHoldersQuery creates a TokenHoldersQuery which gets the holders of a token from the mirror node.
+func NewTokenHoldersQuery() *TokenHoldersQuery {
+	return &TokenHoldersQuery{
+		pageSize: 100,
+	}
+}
+
+// SetTokenID sets the token whose holders are returned.
+func (query *TokenHoldersQuery) SetTokenID(tokenID TokenID) *TokenHoldersQuery {
+	query.tokenID = &tokenID
+	return query
+}
+
+// GetTokenID returns the token whose holders are returned.
+func (query *TokenHoldersQuery) GetTokenID() TokenID {
+	if query.tokenID == nil {
+		return TokenID{}
+	}
+
+	return *query.tokenID
+}
+
+// SetTimestamp sets the consensus timestamp of the balances. The latest balances are returned if it isn't set.
+func (query *TokenHoldersQuery) SetTimestamp(timestamp time.Time) *TokenHoldersQuery {
+	query.timestamp = &timestamp
+	return query
+}
+
+// GetTimestamp returns the consensus timestamp of the balances.
+func (query *TokenHoldersQuery) GetTimestamp() time.Time {
+	if query.timestamp == nil {
+		return time.Time{}
+	}
+
+	return *query.timestamp
+}
+
+// SetMinBalance only returns the holders with at least the given balance, in the smallest denomination of the
+// token. SetMinBalance(1) excludes the accounts which are associated with the token but hold none.
+func (query *TokenHoldersQuery) SetMinBalance(minBalance uint64) *TokenHoldersQuery {
+	query.minBalance = &minBalance
+	return query
+}
+
+// GetMinBalance returns the minimum balance of the returned holders.
+func (query *TokenHoldersQuery) GetMinBalance() uint64 {
+	if query.minBalance == nil {
+		return 0
+	}
+
+	return *query.minBalance
+}
+
+// SetPageSize sets the number of holders requested from the mirror node at once. The default is 100.
+func (query *TokenHoldersQuery) SetPageSize(pageSize int) *TokenHoldersQuery {
+	query.pageSize = pageSize
+	return query
+}
+
+// GetPageSize returns the number of holders requested from the mirror node at once.
+func (query *TokenHoldersQuery) GetPageSize() int {
+	return query.pageSize
+}
+
+// Execute gets all the holders of the token, following the pages of the mirror node.
+func (query *TokenHoldersQuery) Execute(client *Client) ([]TokenHolder, error) {
+	if query.tokenID == nil {
+		return nil, errors.New("token ID is required")
+	}
+
+	var holders []mirror.TokenHolder
+	err := client._CallMirrorRest("5551", func(mirrorClient *mirror.Client) (err error) {
+		request := mirrorClient.ListTokenBalances(query.tokenID.String()).Order(mirror.OrderAsc)
+		if query.pageSize > 0 {
+			request.Limit(query.pageSize)
+		}
+		if query.timestamp != nil {
+			request.Timestamp(mirror.OperatorLte, mirror.TimestampFromTime(*query.timestamp))
+		}
+		if query.minBalance != nil {
+			request.Filter("account.balance", mirror.OperatorGte, strconv.FormatUint(*query.minBalance, 10))
+		}
+		holders, err = request.Iterate(context.Background()).Collect()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]TokenHolder, 0, len(holders))
+	for _, holder := range holders {
+		accountID, err := AccountIDFromString(holder.Account)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, TokenHolder{
+			AccountID: accountID,
+			TokenID:   *query.tokenID,
+			Balance:   uint64(holder.Balance),
+			Decimals:  uint64(holder.Decimals),
+		})
+	}
+
+	return result, nil
+}
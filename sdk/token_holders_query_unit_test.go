@@ -0,0 +1,50 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitTokenHoldersQuery(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/tokens/0.0.7/balances", r.URL.Path)
+		if r.URL.Query().Get("account.id") == "" {
+			assert.Equal(t, "gte:1", r.URL.Query().Get("account.balance"))
+			assert.Equal(t, "2", r.URL.Query().Get("limit"))
+			_, _ = w.Write([]byte(`{"balances":[{"account":"0.0.5","balance":12345,"decimals":2},
+				{"account":"0.0.6","balance":1,"decimals":2}],
+				"links":{"next":"/api/v1/tokens/0.0.7/balances?limit=2&account.balance=gte:1&account.id=gt:0.0.6"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"balances":[{"account":"0.0.9","balance":100,"decimals":2}],"links":{"next":null}}`))
+	}))
+	defer server.Close()
+
+	client := _NewMirrorTestClient(t, server)
+	holders, err := NewTokenHoldersQuery().
+		SetTokenID(TokenID{Token: 7}).
+		SetMinBalance(1).
+		SetPageSize(2).
+		Execute(client)
+	require.NoError(t, err)
+	require.Len(t, holders, 3)
+	assert.Equal(t, AccountID{Account: 5}, holders[0].AccountID)
+	assert.Equal(t, TokenID{Token: 7}, holders[0].TokenID)
+	assert.Equal(t, "123.45", holders[0].String())
+	assert.Equal(t, "0.01", holders[1].String())
+	assert.Equal(t, AccountID{Account: 9}, holders[2].AccountID)
+
+	_, err = NewTokenHoldersQuery().Execute(client)
+	require.Error(t, err)
+}
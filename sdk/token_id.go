@@ -70,6 +70,28 @@ func (id TokenID) ToBytes() []byte {
 	return data
 }
 
+// MarshalJSON implements the encoding.JSON interface.
+func (id TokenID) MarshalJSON() ([]byte, error) {
+	return _StringToJSON(id.String())
+}
+
+// UnmarshalJSON implements the encoding.JSON interface.
+func (id *TokenID) UnmarshalJSON(data []byte) error {
+	value, err := _StringFromJSON(data)
+	if err != nil {
+		return err
+	}
+
+	result, err := TokenIDFromString(value)
+	if err != nil {
+		return err
+	}
+
+	*id = result
+
+	return nil
+}
+
 // TokenIDFromBytes returns a TokenID from a byte array
 func TokenIDFromBytes(data []byte) (TokenID, error) {
 	if data == nil {
@@ -363,6 +363,23 @@ func (tokenInfo TokenInfo) ToBytes() []byte {
 	return data
 }
 
+// MarshalJSON returns the protobuf JSON mapping of the proto.TokenInfo message for the TokenInfo.
+func (tokenInfo TokenInfo) MarshalJSON() ([]byte, error) {
+	return _ProtobufToJSON(tokenInfo._ToProtobuf())
+}
+
+// UnmarshalJSON decodes a TokenInfo from the protobuf JSON mapping of the proto.TokenInfo message.
+func (tokenInfo *TokenInfo) UnmarshalJSON(data []byte) error {
+	pb := services.TokenInfo{}
+	if err := _ProtobufFromJSON(data, &pb); err != nil {
+		return err
+	}
+
+	*tokenInfo = _TokenInfoFromProtobuf(&pb)
+
+	return nil
+}
+
 // TokenInfoFromBytes returns a TokenInfo struct from a raw protobuf byte array
 func TokenInfoFromBytes(data []byte) (TokenInfo, error) {
 	if data == nil {
@@ -107,6 +107,10 @@ func (tx TokenMintTransaction) getName() string {
 	return "TokenMintTransaction"
 }
 
+func (tx TokenMintTransaction) validateBody() []ErrTransactionValidation {
+	return _ValidateNftMetadata(tx.meta)
+}
+
 func (tx TokenMintTransaction) validateNetworkOnIDs(client *Client) error {
 	if client == nil || !client.autoValidateChecksums {
 		return nil
@@ -65,6 +65,23 @@ func (tokenNftInfo *TokenNftInfo) ToBytes() []byte {
 	return data
 }
 
+// MarshalJSON returns the protobuf JSON mapping of the proto.TokenNftInfo message for the TokenNftInfo.
+func (tokenNftInfo TokenNftInfo) MarshalJSON() ([]byte, error) {
+	return _ProtobufToJSON(tokenNftInfo._ToProtobuf())
+}
+
+// UnmarshalJSON decodes a TokenNftInfo from the protobuf JSON mapping of the proto.TokenNftInfo message.
+func (tokenNftInfo *TokenNftInfo) UnmarshalJSON(data []byte) error {
+	pb := services.TokenNftInfo{}
+	if err := _ProtobufFromJSON(data, &pb); err != nil {
+		return err
+	}
+
+	*tokenNftInfo = _TokenNftInfoFromProtobuf(&pb)
+
+	return nil
+}
+
 // TokenNftInfoFromBytes returns the TokenNftInfo from a byte array representation
 func TokenNftInfoFromBytes(data []byte) (TokenNftInfo, error) {
 	if data == nil {
@@ -0,0 +1,133 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"github.com/hiero-ledger/hiero-sdk-go/v2/sdk/mirror"
+	"github.com/pkg/errors"
+)
+
+// TokenNftsQuery lists the NFTs of a token from the mirror node.
+type TokenNftsQuery struct {
+	mirrorNftQuery
+}
+
+// NewTokenNftsQuery creates a TokenNftsQuery which lists the NFTs of a token.
+func NewTokenNftsQuery() *TokenNftsQuery {
+	return &TokenNftsQuery{}
+}
+
+// SetTokenID sets the token whose NFTs are listed.
+func (query *TokenNftsQuery) SetTokenID(tokenID TokenID) *TokenNftsQuery {
+	query.tokenID = &tokenID
+	return query
+}
+
+// GetTokenID returns the token whose NFTs are listed.
+func (query *TokenNftsQuery) GetTokenID() TokenID {
+	if query.tokenID == nil {
+		return TokenID{}
+	}
+
+	return *query.tokenID
+}
+
+// SetAccountID only lists the NFTs owned by the given account.
+func (query *TokenNftsQuery) SetAccountID(accountID AccountID) *TokenNftsQuery {
+	query.accountID = &accountID
+	return query
+}
+
+// GetAccountID returns the owner of the listed NFTs.
+func (query *TokenNftsQuery) GetAccountID() AccountID {
+	if query.accountID == nil {
+		return AccountID{}
+	}
+
+	return *query.accountID
+}
+
+// SetSerialRange only lists the NFTs with a serial number between from and to, inclusive.
+func (query *TokenNftsQuery) SetSerialRange(from int64, to int64) *TokenNftsQuery {
+	query.serialFrom = &from
+	query.serialTo = &to
+	return query
+}
+
+// GetSerialRange returns the range of the serial numbers of the listed NFTs.
+func (query *TokenNftsQuery) GetSerialRange() (int64, int64) {
+	if query.serialFrom == nil || query.serialTo == nil {
+		return 0, 0
+	}
+
+	return *query.serialFrom, *query.serialTo
+}
+
+// SetSpenderID only lists the NFTs the given spender is approved for. The mirror node can't filter the NFTs of a
+// token on their spender, so the other NFTs are still fetched and skipped.
+func (query *TokenNftsQuery) SetSpenderID(spenderID AccountID) *TokenNftsQuery {
+	query.spenderID = &spenderID
+	return query
+}
+
+// GetSpenderID returns the spender of the listed NFTs.
+func (query *TokenNftsQuery) GetSpenderID() AccountID {
+	if query.spenderID == nil {
+		return AccountID{}
+	}
+
+	return *query.spenderID
+}
+
+// SetOrder sets the order of the NFTs, by serial number. The mirror node defaults to descending.
+func (query *TokenNftsQuery) SetOrder(order mirror.Order) *TokenNftsQuery {
+	query.order = order
+	return query
+}
+
+// GetOrder returns the order of the NFTs.
+func (query *TokenNftsQuery) GetOrder() mirror.Order {
+	return query.order
+}
+
+// SetPageSize sets the number of NFTs requested from the mirror node at once.
+func (query *TokenNftsQuery) SetPageSize(pageSize int) *TokenNftsQuery {
+	query.pageSize = pageSize
+	return query
+}
+
+// GetPageSize returns the number of NFTs requested from the mirror node at once.
+func (query *TokenNftsQuery) GetPageSize() int {
+	return query.pageSize
+}
+
+// Iterate returns an iterator over the NFTs, which fetches the pages of the mirror node as they are consumed.
+func (query *TokenNftsQuery) Iterate(client *Client) mirror.Iterator[TokenNftInfo] {
+	if err := query._Validate(); err != nil {
+		return func(yield func(TokenNftInfo, error) bool) {
+			yield(TokenNftInfo{}, err)
+		}
+	}
+
+	return query._Iterate(client, func(mirrorClient *mirror.Client) *mirror.ListRequest[mirror.Nft] {
+		request := mirrorClient.ListTokenNfts(query.tokenID.String())
+		query._ApplyFilters(request)
+		if query.accountID != nil {
+			request.Filter("account.id", mirror.OperatorEq, query.accountID.String())
+		}
+		return request
+	}, true)
+}
+
+// Execute lists all the NFTs.
+func (query *TokenNftsQuery) Execute(client *Client) ([]TokenNftInfo, error) {
+	return query.Iterate(client).Collect()
+}
+
+func (query *TokenNftsQuery) _Validate() error {
+	if query.tokenID == nil {
+		return errors.New("token ID is required")
+	}
+
+	return nil
+}
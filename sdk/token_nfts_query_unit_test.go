@@ -0,0 +1,120 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitTokenNftsQuery(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/tokens/0.0.7/nfts", r.URL.Path)
+		assert.Equal(t, "eq:0.0.5", r.URL.Query().Get("account.id"))
+		_, _ = w.Write([]byte(`{"nfts":[
+			{"account_id":"0.0.5","created_timestamp":"1700000000.000000001","metadata":"","serial_number":2,"spender":"0.0.8","token_id":"0.0.7"},
+			{"account_id":"0.0.5","created_timestamp":"1700000000.000000002","metadata":"","serial_number":1,"spender":null,"token_id":"0.0.7"}],
+			"links":{"next":null}}`))
+	}))
+	defer server.Close()
+
+	client := _NewMirrorTestClient(t, server)
+	nfts, err := NewTokenNftsQuery().
+		SetTokenID(TokenID{Token: 7}).
+		SetAccountID(AccountID{Account: 5}).
+		Execute(client)
+	require.NoError(t, err)
+	require.Len(t, nfts, 2)
+	assert.Equal(t, AccountID{}, nfts[1].SpenderID)
+
+	// The spender is filtered client side
+	nfts, err = NewTokenNftsQuery().
+		SetTokenID(TokenID{Token: 7}).
+		SetAccountID(AccountID{Account: 5}).
+		SetSpenderID(AccountID{Account: 8}).
+		Execute(client)
+	require.NoError(t, err)
+	require.Len(t, nfts, 1)
+	assert.Equal(t, int64(2), nfts[0].NftID.SerialNumber)
+
+	_, err = NewTokenNftsQuery().Execute(client)
+	require.Error(t, err)
+}
+
+func TestUnitTokenNftsQueryStopsAfterError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"nfts":[
+			{"account_id":"0.0.5","created_timestamp":"1700000000.000000001","metadata":"not base64!","serial_number":1,"token_id":"0.0.7"},
+			{"account_id":"0.0.5","created_timestamp":"1700000000.000000002","metadata":"","serial_number":2,"token_id":"0.0.7"}],
+			"links":{"next":null}}`))
+	}))
+	defer server.Close()
+
+	var errs, infos int
+	NewTokenNftsQuery().SetTokenID(TokenID{Token: 7}).Iterate(_NewMirrorTestClient(t, server))(func(info TokenNftInfo, err error) bool {
+		if err != nil {
+			errs++
+		} else {
+			infos++
+		}
+		// The consumer asks for more, but the iterator stops after the error
+		return true
+	})
+	assert.Equal(t, 1, errs)
+	assert.Equal(t, 0, infos)
+}
+
+func TestUnitTokenNftsQueryFailsOverBetweenPages(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			_, _ = w.Write([]byte(`{"nfts":[
+				{"account_id":"0.0.5","created_timestamp":"1700000000.000000002","metadata":"","serial_number":2,"token_id":"0.0.7"}],
+				"links":{"next":null}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"nfts":[
+			{"account_id":"0.0.5","created_timestamp":"1700000000.000000001","metadata":"","serial_number":1,"token_id":"0.0.7"}],
+			"links":{"next":"/api/v1/tokens/0.0.7/nfts?page=2"}}`))
+	}))
+	defer server.Close()
+
+	target, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	// The node which served the first page goes down before the second one
+	var firstHost string
+	client := ClientForNetwork(map[string]AccountID{})
+	client.SetMirrorNetwork([]string{"mirror1.example.com:443", "mirror2.example.com:443"})
+	client.SetMirrorHTTPClient(&http.Client{
+		Transport: _MirrorRoundTripper(func(request *http.Request) (*http.Response, error) {
+			if firstHost == "" {
+				firstHost = request.URL.Host
+			} else if request.URL.Host == firstHost {
+				return nil, errors.New("connection refused")
+			}
+			request.URL.Scheme = target.Scheme
+			request.URL.Host = target.Host
+			return http.DefaultTransport.RoundTrip(request)
+		}),
+	})
+
+	nfts, err := NewTokenNftsQuery().SetTokenID(TokenID{Token: 7}).Execute(client)
+	require.NoError(t, err)
+	require.Len(t, nfts, 2)
+	assert.Equal(t, int64(2), nfts[1].NftID.SerialNumber)
+}
@@ -427,6 +427,23 @@ func (tx TokenUpdateTransaction) getName() string {
 	return "TokenUpdateTransaction"
 }
 
+func (tx TokenUpdateTransaction) validateBody() []ErrTransactionValidation {
+	violations := make([]ErrTransactionValidation, 0)
+	if tx.memo != nil {
+		violations = append(violations, _ValidateMemo("token memo", *tx.memo)...)
+	}
+	if len(tx.tokenName) > maxTokenNameBytes {
+		violations = append(violations, _NewErrTransactionValidation(StatusTokenNameTooLong,
+			"token name is %d bytes, the limit is %d bytes", len(tx.tokenName), maxTokenNameBytes))
+	}
+	if len(tx.tokenSymbol) > maxTokenSymbolBytes {
+		violations = append(violations, _NewErrTransactionValidation(StatusTokenSymbolTooLong,
+			"token symbol is %d bytes, the limit is %d bytes", len(tx.tokenSymbol), maxTokenSymbolBytes))
+	}
+
+	return violations
+}
+
 func (tx TokenUpdateTransaction) validateNetworkOnIDs(client *Client) error {
 	if client == nil || !client.autoValidateChecksums {
 		return nil
@@ -229,6 +229,10 @@ func (tx TopicCreateTransaction) getName() string {
 	return "TopicCreateTransaction"
 }
 
+func (tx TopicCreateTransaction) validateBody() []ErrTransactionValidation {
+	return _ValidateMemo("topic memo", tx.memo)
+}
+
 func (tx TopicCreateTransaction) validateNetworkOnIDs(client *Client) error {
 	if client == nil || !client.autoValidateChecksums {
 		return nil
@@ -125,6 +125,28 @@ func (topicInfo TopicInfo) ToBytes() []byte {
 	return data
 }
 
+// MarshalJSON returns the protobuf JSON mapping of the proto.ConsensusTopicInfo message for the TopicInfo.
+func (topicInfo TopicInfo) MarshalJSON() ([]byte, error) {
+	return _ProtobufToJSON(topicInfo._ToProtobuf())
+}
+
+// UnmarshalJSON decodes a TopicInfo from the protobuf JSON mapping of the proto.ConsensusTopicInfo message.
+func (topicInfo *TopicInfo) UnmarshalJSON(data []byte) error {
+	pb := services.ConsensusTopicInfo{}
+	if err := _ProtobufFromJSON(data, &pb); err != nil {
+		return err
+	}
+
+	result, err := _TopicInfoFromProtobuf(&pb)
+	if err != nil {
+		return err
+	}
+
+	*topicInfo = result
+
+	return nil
+}
+
 // TopicInfoFromBytes returns a TopicInfo object from a byte array
 func TopicInfoFromBytes(data []byte) (TopicInfo, error) {
 	if data == nil {
@@ -171,7 +171,6 @@ func (query *TopicMessageQuery) build() *mirror.ConsensusTopicQuery {
 func (query *TopicMessageQuery) Subscribe(client *Client, onNext func(TopicMessage)) (SubscriptionHandle, error) {
 	var once sync.Once
 	done := make(chan struct{})
-	handle := SubscriptionHandle{}
 
 	err := query.validateNetworkOnIDs(client)
 	if err != nil {
@@ -182,25 +181,39 @@ func (query *TopicMessageQuery) Subscribe(client *Client, onNext func(TopicMessa
 
 	messages := make(map[string][]*mirror.ConsensusTopicResponse)
 
-	channel, err := client.mirrorNetwork._GetNextMirrorNode()._GetConsensusServiceClient()
+	node := client.mirrorNetwork._GetNextMirrorNode()
+	channel, err := node._GetConsensusServiceClient()
 	if err != nil {
-		return handle, err
+		return SubscriptionHandle{}, err
 	}
 
+	// Every stream, including the ones resuming on another mirror node, is cancelled by the handle
+	subscriptionCtx, cancelSubscription := context.WithCancel(context.TODO())
+	handle := SubscriptionHandle{onUnsubscribe: cancelSubscription}
+
 	go func() {
 		query.mu.Lock()
 		defer query.mu.Unlock()
 		var subClient mirror.ConsensusService_SubscribeTopicClient
+		var cancelStream context.CancelFunc
 		var err error
+		receiving := false
 
 		for {
 			if err != nil {
-				handle.Unsubscribe()
+				cancelStream()
 
 				if grpcErr, ok := status.FromError(err); ok { // nolint
 					if query.attempt < query.maxAttempts && query.retryHandler(err) {
 						subClient = nil
 
+						// Resume on another mirror node, from the message after the last one received
+						client.mirrorNetwork._IncreaseBackoff(node)
+						node = client.mirrorNetwork._GetNextMirrorNode()
+						if nextChannel, channelErr := node._GetConsensusServiceClient(); channelErr == nil {
+							channel = nextChannel
+						}
+
 						delay := math.Min(250.0*math.Pow(2.0, float64(query.attempt)), 8000)
 						time.Sleep(time.Duration(delay) * time.Millisecond)
 						query.attempt++
@@ -217,8 +230,8 @@ func (query *TopicMessageQuery) Subscribe(client *Client, onNext func(TopicMessa
 			}
 
 			if subClient == nil {
-				ctx, cancel := context.WithCancel(context.TODO())
-				handle.onUnsubscribe = cancel
+				var ctx context.Context
+				ctx, cancelStream = context.WithCancel(subscriptionCtx)
 				once.Do(func() {
 					close(done)
 				})
@@ -227,6 +240,7 @@ func (query *TopicMessageQuery) Subscribe(client *Client, onNext func(TopicMessa
 				if err != nil {
 					continue
 				}
+				receiving = false
 			}
 
 			var resp *mirror.ConsensusTopicResponse
@@ -236,6 +250,11 @@ func (query *TopicMessageQuery) Subscribe(client *Client, onNext func(TopicMessa
 				continue
 			}
 
+			if !receiving {
+				client.mirrorNetwork._DecreaseBackoff(node)
+				receiving = true
+			}
+
 			if resp.ConsensusTimestamp != nil {
 				pb.ConsensusStartTime = _TimeToProtobuf(_TimeFromProtobuf(resp.ConsensusTimestamp).Add(1 * time.Nanosecond))
 			}
@@ -6,11 +6,17 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"net"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/mirror"
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
@@ -84,3 +90,88 @@ func TestUnitTopicMessageQueryNothingSet(t *testing.T) {
 	balance.GetEndTime()
 	balance.GetLimit()
 }
+
+func TestUnitTopicMessageQueryResumesOnAnotherMirror(t *testing.T) {
+	t.Parallel()
+
+	var mutex sync.Mutex
+	var servedBy []int
+	var startTimes []*services.Timestamp
+
+	newServer := func(index int) (*grpc.Server, string) {
+		server := grpc.NewServer()
+		server.RegisterService(NewMirrorServiceDescription(func(_ interface{}, stream grpc.ServerStream) error {
+			request := new(mirror.ConsensusTopicQuery)
+			if err := stream.RecvMsg(request); err != nil {
+				return err
+			}
+
+			mutex.Lock()
+			servedBy = append(servedBy, index)
+			startTimes = append(startTimes, request.ConsensusStartTime)
+			first := len(servedBy) == 1
+			mutex.Unlock()
+
+			if first {
+				if err := stream.SendMsg(&mirror.ConsensusTopicResponse{
+					ConsensusTimestamp: &services.Timestamp{Seconds: 100},
+					Message:            []byte("first"),
+					SequenceNumber:     1,
+				}); err != nil {
+					return err
+				}
+				return status.Error(codes.Unavailable, "mirror node is going away")
+			}
+
+			return stream.SendMsg(&mirror.ConsensusTopicResponse{
+				ConsensusTimestamp: &services.Timestamp{Seconds: 200},
+				Message:            []byte("second"),
+				SequenceNumber:     2,
+			})
+		}, &mirror.ConsensusService_ServiceDesc), nil)
+
+		listener, err := net.Listen("tcp", "localhost:0")
+		require.NoError(t, err)
+		go func() {
+			_ = server.Serve(listener)
+		}()
+
+		return server, listener.Addr().String()
+	}
+
+	server1, address1 := newServer(1)
+	defer server1.Stop()
+	server2, address2 := newServer(2)
+	defer server2.Stop()
+
+	client := ClientForNetwork(map[string]AccountID{})
+	client.SetMirrorNetwork([]string{address1, address2})
+	defer client.Close()
+
+	messages := make(chan TopicMessage, 2)
+	completed := make(chan struct{})
+	topicID := TopicID{Topic: 7}
+	_, err := NewTopicMessageQuery().
+		SetTopicID(topicID).
+		SetCompletionHandler(func() { close(completed) }).
+		Subscribe(client, func(message TopicMessage) {
+			messages <- message
+		})
+	require.NoError(t, err)
+
+	select {
+	case <-completed:
+	case <-time.After(10 * time.Second):
+		t.Fatal("subscription did not complete")
+	}
+	require.Len(t, messages, 2)
+	assert.Equal(t, []byte("first"), (<-messages).Contents)
+	assert.Equal(t, []byte("second"), (<-messages).Contents)
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	require.Len(t, servedBy, 2)
+	assert.NotEqual(t, servedBy[0], servedBy[1])
+	assert.Equal(t, int64(100), startTimes[1].Seconds)
+	assert.Equal(t, int32(1), startTimes[1].Nanos)
+}
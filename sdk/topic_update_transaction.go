@@ -302,6 +302,10 @@ func (tx TopicUpdateTransaction) getName() string {
 	return "TopicUpdateTransaction"
 }
 
+func (tx TopicUpdateTransaction) validateBody() []ErrTransactionValidation {
+	return _ValidateMemo("topic memo", tx.memo)
+}
+
 func (tx TopicUpdateTransaction) validateNetworkOnIDs(client *Client) error {
 	if client == nil || !client.autoValidateChecksums {
 		return nil
@@ -7,6 +7,8 @@ import (
 	"crypto/sha512"
 	"fmt"
 	"reflect"
+	"runtime"
+	"sync"
 
 	"github.com/pkg/errors"
 
@@ -30,6 +32,7 @@ type TransactionInterface interface {
 	buildScheduled() (*services.SchedulableTransactionBody, error)            // builds the protobuf payload for the scheduled transaction
 	preFreezeWith(*Client, TransactionInterface)                              // utility method to set the transaction fields before freezing
 	constructScheduleProtobuf() (*services.SchedulableTransactionBody, error) // TODO remove this method if possible
+	validateBody() []ErrTransactionValidation                                 // transaction type specific precheck rules used by Validate
 	// NOTE: Any changes to the baseTransaction retuned by getBaseTransaction()
 	// will be reflected in the transaction object
 	getBaseTransaction() *Transaction[TransactionInterface]
@@ -49,6 +52,9 @@ type BaseTransaction struct {
 	publicKeys         []PublicKey
 	transactionSigners []TransactionSigner
 	customFeeLimits    []*CustomFeeLimit
+
+	builtTransactions *_BuiltTransactionCache
+	maxSigningWorkers int
 }
 
 // Transaction is base struct for all transactions that may be built and submitted to hiero.
@@ -197,7 +203,8 @@ func TransactionFromBytes(data []byte) (TransactionInterface, error) { // nolint
 			nodeAccountID = *_AccountIDFromProtobuf(body.GetNodeAccountID())
 		}
 
-		if body.GetMaxCustomFees() != nil {
+		// Every node body holds the same limits, so they are only read from the first one
+		if i == 0 && body.GetMaxCustomFees() != nil {
 			for _, customFeeLimit := range body.GetMaxCustomFees() {
 				baseTx.customFeeLimits = append(baseTx.customFeeLimits, customFeeLimitFromProtobuf(customFeeLimit))
 			}
@@ -341,7 +348,7 @@ func TransactionFromBytes(data []byte) (TransactionInterface, error) { // nolint
 	case *services.TransactionBody_TokenClaimAirdrop:
 		childTx = _TokenClaimAirdropTransactionFromProtobuf(*castFromBaseToConcreteTransaction[*TokenClaimAirdropTransaction](baseTx), first)
 	default:
-		return nil, errFailedToDeserializeBytes
+		return _DecodeUnknownTransaction(_UnknownTransactionFromProtobuf(*castFromBaseToConcreteTransaction[*UnknownTransaction](baseTx), first))
 	}
 
 	// --- //
@@ -535,7 +542,7 @@ func transactionFromScheduledTransaction(scheduledBody *services.SchedulableTran
 		}
 		tx = _ScheduleDeleteTransactionFromProtobuf(*castFromBaseToConcreteTransaction[*ScheduleDeleteTransaction](baseTx), pbBody)
 	default:
-		return nil, errors.New("unrecognized transaction type")
+		return _DecodeUnknownTransaction(_UnknownTransactionFromScheduledProtobuf(*castFromBaseToConcreteTransaction[*UnknownTransaction](baseTx), scheduledBody))
 	}
 
 	return tx, nil
@@ -692,136 +699,246 @@ func (tx *Transaction[T]) buildUnsignedTransaction(index int) (*services.Transac
 	return &services.Transaction{BodyBytes: bodyBytes}, nil
 }
 
-func (tx *Transaction[T]) _SignTransaction(index int) {
-	initialTx := tx.signedTransactions._Get(index).(*services.SignedTransaction)
-	bodyBytes := initialTx.GetBodyBytes()
-	if len(initialTx.SigMap.SigPair) != 0 {
-		for i, key := range tx.publicKeys {
-			if tx.transactionSigners[i] != nil {
-				if key.ed25519PublicKey != nil {
-					if bytes.Equal(initialTx.SigMap.SigPair[0].PubKeyPrefix, key.ed25519PublicKey.keyData) {
-						if !tx.regenerateTransactionID {
-							return
-						}
-						switch t := initialTx.SigMap.SigPair[0].Signature.(type) { //nolint
-						case *services.SignaturePair_Ed25519:
-							if bytes.Equal(t.Ed25519, tx.transactionSigners[0](bodyBytes)) && len(t.Ed25519) > 0 {
-								return
-							}
-						}
-					}
-				}
-				if key.ecdsaPublicKey != nil {
-					if bytes.Equal(initialTx.SigMap.SigPair[0].PubKeyPrefix, key.ecdsaPublicKey._BytesRaw()) {
-						if !tx.regenerateTransactionID {
-							return
-						}
-						switch t := initialTx.SigMap.SigPair[0].Signature.(type) { //nolint
-						case *services.SignaturePair_ECDSASecp256K1:
-							if bytes.Equal(t.ECDSASecp256K1, tx.transactionSigners[0](bodyBytes)) && len(t.ECDSASecp256K1) > 0 {
-								return
-							}
-						}
-					}
-				}
-			}
+// _BuiltTransaction caches what was last built for one signed transaction, so bodies whose inputs did not change
+// are neither unmarshalled and marshalled again nor signed again.
+type _BuiltTransaction struct {
+	bodyState   []byte // the fields _UpdateBody writes, encoded as a TransactionBody
+	sigPairs    int    // the number of signature pairs transaction was serialized with
+	transaction *services.Transaction
+}
+
+type _BuiltTransactionCache struct {
+	sync.Mutex
+	built map[*services.SignedTransaction]*_BuiltTransaction
+}
+
+func (cache *_BuiltTransactionCache) _Get(signedTx *services.SignedTransaction) *_BuiltTransaction {
+	cache.Lock()
+	defer cache.Unlock()
+	return cache.built[signedTx]
+}
+
+func (cache *_BuiltTransactionCache) _Set(signedTx *services.SignedTransaction, built *_BuiltTransaction) {
+	cache.Lock()
+	defer cache.Unlock()
+	cache.built[signedTx] = built
+}
+
+func (tx *Transaction[T]) _InitBuiltTransactionCache() {
+	if tx.builtTransactions == nil {
+		tx.builtTransactions = &_BuiltTransactionCache{
+			built: make(map[*services.SignedTransaction]*_BuiltTransaction),
 		}
 	}
+}
+
+// SetMaxSigningWorkers sets how many signed transactions are built and signed concurrently when the transaction
+// is serialized for every node. Defaults to GOMAXPROCS, bounded by the number of nodes, so every TransactionSigner
+// must be safe for concurrent use; set it to 1 when a signer isn't, eg. one backed by a hardware wallet.
+func (tx *Transaction[T]) SetMaxSigningWorkers(workers int) T {
+	tx.maxSigningWorkers = workers
+	return tx.childTransaction
+}
 
-	if tx.regenerateTransactionID && !tx.transactionIDs.locked {
-		modifiedTx := tx.signedTransactions._Get(index).(*services.SignedTransaction)
-		modifiedTx.SigMap.SigPair = make([]*services.SignaturePair, 0)
-		tx.signedTransactions._Set(index, modifiedTx)
+// GetMaxSigningWorkers returns how many signed transactions are built and signed concurrently
+func (tx *Transaction[T]) GetMaxSigningWorkers() int {
+	if tx.maxSigningWorkers > 0 {
+		return tx.maxSigningWorkers
 	}
 
-	for i := 0; i < len(tx.publicKeys); i++ {
-		publicKey := (tx.publicKeys)[i]
-		signer := tx.transactionSigners[i]
+	return runtime.GOMAXPROCS(0)
+}
 
-		if signer == nil {
+// _SignTransaction adds the signature of every signer whose key is not yet in the signature map
+func (tx *Transaction[T]) _SignTransaction(signedTx *services.SignedTransaction) {
+	for i, publicKey := range tx.publicKeys {
+		signer := tx.transactionSigners[i]
+		if signer == nil || _SigPairsContainKey(signedTx.SigMap.SigPair, publicKey) {
 			continue
 		}
 
-		signature := signer(bodyBytes)
+		signature := signer(signedTx.BodyBytes)
 		if len(signature) == 65 {
 			signature = signature[1:]
 		}
-		modifiedTx := tx.signedTransactions._Get(index).(*services.SignedTransaction)
-		modifiedTx.SigMap.SigPair = append(modifiedTx.SigMap.SigPair, publicKey._ToSignaturePairProtobuf(signature))
-		tx.signedTransactions._Set(index, modifiedTx)
+		signedTx.SigMap.SigPair = append(signedTx.SigMap.SigPair, publicKey._ToSignaturePairProtobuf(signature))
 	}
 }
 
-func (tx *Transaction[T]) _BuildAllTransactions() ([]*services.Transaction, error) {
-	allTx := make([]*services.Transaction, 0)
-	for i := 0; i < tx.signedTransactions._Length(); i++ {
-		curr, err := tx._BuildTransaction(i)
-		tx.transactionIDs._Advance()
-		if err != nil {
-			return []*services.Transaction{}, err
+// _RemoveSignerSignatures drops the signatures that the signers of the transaction will add again
+func (tx *Transaction[T]) _RemoveSignerSignatures(signedTx *services.SignedTransaction) {
+	sigPairs := make([]*services.SignaturePair, 0, len(signedTx.SigMap.SigPair))
+	for _, sigPair := range signedTx.SigMap.SigPair {
+		resigned := false
+		for i, publicKey := range tx.publicKeys {
+			if tx.transactionSigners[i] != nil && _SigPairsContainKey([]*services.SignaturePair{sigPair}, publicKey) {
+				resigned = true
+				break
+			}
+		}
+		if !resigned {
+			sigPairs = append(sigPairs, sigPair)
 		}
-		allTx = append(allTx, curr)
 	}
-
-	return allTx, nil
+	signedTx.SigMap.SigPair = sigPairs
 }
 
-func (tx *Transaction[T]) _BuildTransaction(index int) (*services.Transaction, error) {
-	signedTx := tx.signedTransactions._Get(index).(*services.SignedTransaction)
+// _BodyState encodes the body fields that may change after the transaction was frozen
+func (tx *Transaction[T]) _BodyState(txID TransactionID) []byte {
+	state := &services.TransactionBody{
+		TransactionID: txID._ToProtobuf(),
+		Memo:          tx.memo,
+	}
+
+	if tx.transactionFee != 0 {
+		state.TransactionFee = tx.transactionFee
+	} else {
+		state.TransactionFee = tx.defaultMaxTransactionFee
+	}
+
+	for _, customFeeLimit := range tx.customFeeLimits {
+		state.MaxCustomFees = append(state.MaxCustomFees, customFeeLimit.toProtobuf())
+	}
+
+	data, _ := protobuf.Marshal(state)
+	return data
+}
 
-	txID := tx.transactionIDs._GetCurrent().(TransactionID)
-	originalBody := services.TransactionBody{}
-	_ = protobuf.Unmarshal(signedTx.BodyBytes, &originalBody)
+// _UpdateBody writes the fields that may change after the transaction was frozen into the body bytes
+func (tx *Transaction[T]) _UpdateBody(bodyBytes []byte, txID TransactionID) ([]byte, error) {
+	body := services.TransactionBody{}
+	_ = protobuf.Unmarshal(bodyBytes, &body)
+	original := protobuf.Clone(&body)
 
-	if originalBody.NodeAccountID == nil {
-		originalBody.NodeAccountID = tx.nodeAccountIDs._GetCurrent().(AccountID)._ToProtobuf()
+	if body.NodeAccountID == nil {
+		body.NodeAccountID = tx.nodeAccountIDs._GetCurrent().(AccountID)._ToProtobuf()
 	}
 
-	if originalBody.TransactionID.String() != txID._ToProtobuf().String() {
-		originalBody.TransactionID = txID._ToProtobuf()
+	if body.TransactionID.String() != txID._ToProtobuf().String() {
+		body.TransactionID = txID._ToProtobuf()
 	}
 
-	originalBody.Memo = tx.memo
+	body.Memo = tx.memo
 	if tx.transactionFee != 0 {
-		originalBody.TransactionFee = tx.transactionFee
+		body.TransactionFee = tx.transactionFee
 	} else {
-		originalBody.TransactionFee = tx.defaultMaxTransactionFee
+		body.TransactionFee = tx.defaultMaxTransactionFee
 	}
 
 	if tx.customFeeLimits != nil {
+		// The body may already hold the limits when it is built again
+		body.MaxCustomFees = make([]*services.CustomFeeLimit, 0, len(tx.customFeeLimits))
 		for _, customFeeLimit := range tx.customFeeLimits {
-			originalBody.MaxCustomFees = append(originalBody.MaxCustomFees, customFeeLimit.toProtobuf())
+			body.MaxCustomFees = append(body.MaxCustomFees, customFeeLimit.toProtobuf())
 		}
 	}
 
-	updatedBody, err := protobuf.Marshal(&originalBody)
+	// Marshalling again may reorder the fields the SDK doesn't know, which would invalidate the signatures of an
+	// unchanged body
+	if protobuf.Equal(original, &body) {
+		return bodyBytes, nil
+	}
+
+	updatedBody, err := protobuf.Marshal(&body)
 	if err != nil {
-		return &services.Transaction{}, errors.Wrap(err, "failed to update tx ID")
+		return nil, errors.Wrap(err, "failed to update tx ID")
 	}
 
-	// Bellow are checks whether we need to sign the transaction or we already have the same signed
-	if bytes.Equal(signedTx.BodyBytes, updatedBody) {
-		sigPairLen := len(signedTx.SigMap.GetSigPair())
-		// For cases where we need more than 1 signature
-		if sigPairLen > 0 && sigPairLen == len(tx.publicKeys) {
-			data, err := protobuf.Marshal(signedTx)
-			if err != nil {
-				return &services.Transaction{}, errors.Wrap(err, "failed to serialize transactions for building")
-			}
-			transaction := &services.Transaction{
-				SignedTransactionBytes: data,
+	return updatedBody, nil
+}
+
+func (tx *Transaction[T]) _BuildAllTransactions() ([]*services.Transaction, error) {
+	length := tx.signedTransactions._Length()
+	transactionIDs := make([]TransactionID, length)
+	for i := 0; i < length; i++ {
+		transactionIDs[i] = tx.transactionIDs._GetCurrent().(TransactionID)
+		tx.transactionIDs._Advance()
+	}
+
+	tx._InitBuiltTransactionCache()
+
+	allTx := make([]*services.Transaction, length)
+	errs := make([]error, length)
+
+	workers := tx.GetMaxSigningWorkers()
+	if workers > length {
+		workers = length
+	}
+
+	indexes := make(chan int, length)
+	for i := 0; i < length; i++ {
+		indexes <- i
+	}
+	close(indexes)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				allTx[i], errs[i] = tx._BuildSignedTransaction(i, transactionIDs[i])
 			}
+		}()
+	}
+	wg.Wait()
 
-			return transaction, nil
+	for _, err := range errs {
+		if err != nil {
+			return []*services.Transaction{}, err
 		}
 	}
 
-	signedTx.BodyBytes = updatedBody
-	tx.signedTransactions._Set(index, signedTx)
-	tx._SignTransaction(index)
+	return allTx, nil
+}
+
+func (tx *Transaction[T]) _BuildTransaction(index int) (*services.Transaction, error) {
+	tx._InitBuiltTransactionCache()
+	return tx._BuildSignedTransaction(index, tx.transactionIDs._GetCurrent().(TransactionID))
+}
+
+// _BuildSignedTransaction builds the signed transaction at index, only marshalling the body again when one of the
+// fields that may change after freezing did change, and only signing with signers that didn't sign the body yet.
+// Safe to call concurrently for different indexes.
+func (tx *Transaction[T]) _BuildSignedTransaction(index int, txID TransactionID) (*services.Transaction, error) {
+	signedTx := tx.signedTransactions._Get(index).(*services.SignedTransaction)
+	if signedTx.SigMap == nil {
+		signedTx.SigMap = &services.SignatureMap{}
+	}
+
+	bodyState := tx._BodyState(txID)
+	cached := tx.builtTransactions._Get(signedTx)
+	if cached == nil || !bytes.Equal(cached.bodyState, bodyState) {
+		updatedBody, err := tx._UpdateBody(signedTx.BodyBytes, txID)
+		if err != nil {
+			return &services.Transaction{}, err
+		}
+
+		if !bytes.Equal(signedTx.BodyBytes, updatedBody) {
+			signedTx.BodyBytes = updatedBody
+			if tx.regenerateTransactionID && !tx.transactionIDs.locked {
+				signedTx.SigMap.SigPair = make([]*services.SignaturePair, 0)
+			} else {
+				tx._RemoveSignerSignatures(signedTx)
+			}
+			cached = nil
+		}
+	}
+
+	tx._SignTransaction(signedTx)
+
+	if cached != nil && cached.sigPairs == len(signedTx.SigMap.SigPair) {
+		if !bytes.Equal(cached.bodyState, bodyState) {
+			tx.builtTransactions._Set(signedTx, &_BuiltTransaction{
+				bodyState:   bodyState,
+				sigPairs:    cached.sigPairs,
+				transaction: cached.transaction,
+			})
+		}
+		return cached.transaction, nil
+	}
 
-	signed := tx.signedTransactions._Get(index).(*services.SignedTransaction)
-	data, err := protobuf.Marshal(signed)
+	data, err := protobuf.Marshal(signedTx)
 	if err != nil {
 		return &services.Transaction{}, errors.Wrap(err, "failed to serialize transactions for building")
 	}
@@ -829,6 +946,11 @@ func (tx *Transaction[T]) _BuildTransaction(index int) (*services.Transaction, e
 	transaction := &services.Transaction{
 		SignedTransactionBytes: data,
 	}
+	tx.builtTransactions._Set(signedTx, &_BuiltTransaction{
+		bodyState:   bodyState,
+		sigPairs:    len(signedTx.SigMap.SigPair),
+		transaction: transaction,
+	})
 
 	return transaction, nil
 }
@@ -965,6 +1087,37 @@ func (tx *Transaction[T]) ToBytes() ([]byte, error) {
 	return pbTransactionList, nil
 }
 
+// ToJSON converts the current transaction to the protobuf JSON mapping of the transaction list ToBytes produces.
+// Requires transaction to be frozen
+func (tx *Transaction[T]) ToJSON() ([]byte, error) {
+	data, err := tx.ToBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	list := sdk.TransactionList{}
+	if err := protobuf.Unmarshal(data, &list); err != nil {
+		return nil, err
+	}
+
+	return _ProtobufToJSON(&list)
+}
+
+// TransactionFromJSON converts the JSON produced by ToJSON to a related *transaction.
+func TransactionFromJSON(data []byte) (TransactionInterface, error) {
+	list := sdk.TransactionList{}
+	if err := _ProtobufFromJSON(data, &list); err != nil {
+		return nil, errors.Wrap(err, "error deserializing from json to transaction List")
+	}
+
+	bytes, err := protobuf.Marshal(&list)
+	if err != nil {
+		return nil, err
+	}
+
+	return TransactionFromBytes(bytes)
+}
+
 // GetMaxTransactionFee returns the maximum transaction fee the operator (paying account) is willing to pay.
 func (tx *Transaction[T]) GetMaxTransactionFee() Hbar {
 	return HbarFromTinybar(int64(tx.transactionFee))
@@ -1415,6 +1568,10 @@ func TransactionToBytes(tx TransactionInterface) ([]byte, error) {
 	return tx.getBaseTransaction().ToBytes()
 }
 
+func TransactionToJSON(tx TransactionInterface) ([]byte, error) {
+	return tx.getBaseTransaction().ToJSON()
+}
+
 func TransactionString(tx TransactionInterface) (string, error) {
 	return tx.getBaseTransaction().String(), nil
 }
@@ -1502,6 +1659,17 @@ func TransactionSetTransactionID(tx TransactionInterface, transactionID Transact
 	return tx, nil
 }
 
+// TransactionValidate returns every violation found by Validate, and the first of them as the error
+// when the transaction fails validation
+func TransactionValidate(tx TransactionInterface) ([]ErrTransactionValidation, error) {
+	violations := tx.getBaseTransaction().Validate()
+	if len(violations) > 0 {
+		return violations, violations[0]
+	}
+
+	return violations, nil
+}
+
 func TransactionGetSignatures(tx TransactionInterface) (map[AccountID]map[*PublicKey][]byte, error) {
 	return tx.getBaseTransaction().GetSignatures()
 }
@@ -0,0 +1,227 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"fmt"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+func _NewBuildTestTransaction(nodes int) *TransferTransaction {
+	nodeAccountIDs := make([]AccountID, 0, nodes)
+	for i := 0; i < nodes; i++ {
+		nodeAccountIDs = append(nodeAccountIDs, AccountID{Account: uint64(3 + i)})
+	}
+
+	return NewTransferTransaction().
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-100)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(100)).
+		SetTransactionID(testTransactionID).
+		SetNodeAccountIDs(nodeAccountIDs)
+}
+
+func TestUnitTransactionBuildReusesSignedTransactions(t *testing.T) {
+	t.Parallel()
+
+	tx, err := _NewBuildTestTransaction(4).Freeze()
+	require.NoError(t, err)
+
+	calls := 0
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	tx.SignWith(key.PublicKey(), func(message []byte) []byte {
+		calls++
+		return key.Sign(message)
+	})
+
+	first, err := tx.ToBytes()
+	require.NoError(t, err)
+	assert.Equal(t, 4, calls)
+
+	second, err := tx.ToBytes()
+	require.NoError(t, err)
+	_, err = tx.GetTransactionHashPerNode()
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+	assert.Equal(t, 4, calls)
+
+	otherKey, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	tx.Sign(otherKey)
+
+	third, err := tx.ToBytes()
+	require.NoError(t, err)
+	assert.NotEqual(t, first, third)
+	assert.Equal(t, 4, calls)
+
+	signatures, err := tx.GetSignatures()
+	require.NoError(t, err)
+	for _, nodeSignatures := range signatures {
+		assert.Len(t, nodeSignatures, 2)
+	}
+}
+
+func TestUnitTransactionBuildSigningWorkers(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	for _, workers := range []int{0, 1, 4} {
+		tx, err := _NewBuildTestTransaction(8).Freeze()
+		require.NoError(t, err)
+		if workers > 0 {
+			tx.SetMaxSigningWorkers(workers)
+		}
+
+		var inFlight, maxInFlight int32
+		tx.SignWith(key.PublicKey(), func(message []byte) []byte {
+			current := atomic.AddInt32(&inFlight, 1)
+			for {
+				previous := atomic.LoadInt32(&maxInFlight)
+				if current <= previous || atomic.CompareAndSwapInt32(&maxInFlight, previous, current) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			atomic.AddInt32(&inFlight, -1)
+			return key.Sign(message)
+		})
+
+		_, err = tx.ToBytes()
+		require.NoError(t, err)
+
+		// By default signers are called concurrently by up to GOMAXPROCS workers, bounded by the number of nodes
+		switch workers {
+		case 0:
+			assert.Equal(t, runtime.GOMAXPROCS(0), tx.GetMaxSigningWorkers())
+			assert.LessOrEqual(t, maxInFlight, int32(runtime.GOMAXPROCS(0)))
+			assert.LessOrEqual(t, maxInFlight, int32(8))
+		case 1:
+			assert.Equal(t, int32(1), maxInFlight)
+		default:
+			assert.LessOrEqual(t, maxInFlight, int32(workers))
+		}
+	}
+}
+
+func TestUnitTransactionBuildResignsChangedBodies(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	tx, err := _NewBuildTestTransaction(2).Freeze()
+	require.NoError(t, err)
+	tx.Sign(key)
+
+	_, err = tx.ToBytes()
+	require.NoError(t, err)
+
+	tx.transactionFee = 12345
+	_, err = tx.ToBytes()
+	require.NoError(t, err)
+
+	for i := 0; i < tx.signedTransactions._Length(); i++ {
+		signedTx := tx.signedTransactions._Get(i).(*services.SignedTransaction)
+		body := services.TransactionBody{}
+		require.NoError(t, protobuf.Unmarshal(signedTx.BodyBytes, &body))
+		assert.Equal(t, uint64(12345), body.TransactionFee)
+
+		require.Len(t, signedTx.SigMap.SigPair, 1)
+		assert.True(t, key.PublicKey().Verify(signedTx.BodyBytes, signedTx.SigMap.SigPair[0].GetEd25519()))
+	}
+}
+
+func BenchmarkTransactionToBytes(b *testing.B) {
+	keys := make([]PrivateKey, 0, 3)
+	for i := 0; i < 3; i++ {
+		key, err := PrivateKeyGenerateEd25519()
+		require.NoError(b, err)
+		keys = append(keys, key)
+	}
+
+	workerCounts := []int{1}
+	if runtime.GOMAXPROCS(0) > 1 {
+		workerCounts = append(workerCounts, runtime.GOMAXPROCS(0))
+	}
+
+	for _, nodes := range []int{1, 8, 32} {
+		for _, workers := range workerCounts {
+			b.Run(fmt.Sprintf("nodes=%d/workers=%d/first", nodes, workers), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					b.StopTimer()
+					tx, err := _NewBuildTestTransaction(nodes).SetMaxSigningWorkers(workers).Freeze()
+					require.NoError(b, err)
+					for _, key := range keys {
+						tx.Sign(key)
+					}
+					b.StartTimer()
+
+					_, err = tx.ToBytes()
+					require.NoError(b, err)
+				}
+			})
+		}
+
+		// Baseline without the cache of built transactions, every call marshals each node body again
+		b.Run(fmt.Sprintf("nodes=%d/uncached", nodes), func(b *testing.B) {
+			tx, err := _NewBuildTestTransaction(nodes).SetMaxSigningWorkers(1).Freeze()
+			require.NoError(b, err)
+			for _, key := range keys {
+				tx.Sign(key)
+			}
+			_, err = tx.ToBytes()
+			require.NoError(b, err)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				tx.builtTransactions = nil
+				_, err = tx.ToBytes()
+				require.NoError(b, err)
+			}
+		})
+
+		b.Run(fmt.Sprintf("nodes=%d/cached", nodes), func(b *testing.B) {
+			tx, err := _NewBuildTestTransaction(nodes).Freeze()
+			require.NoError(b, err)
+			for _, key := range keys {
+				tx.Sign(key)
+			}
+			_, err = tx.ToBytes()
+			require.NoError(b, err)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, err = tx.ToBytes()
+				require.NoError(b, err)
+			}
+		})
+	}
+}
+
+func BenchmarkTransactionGetTransactionHashPerNode(b *testing.B) {
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(b, err)
+
+	tx, err := _NewBuildTestTransaction(32).Freeze()
+	require.NoError(b, err)
+	tx.Sign(key)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err = tx.GetTransactionHashPerNode()
+		require.NoError(b, err)
+	}
+}
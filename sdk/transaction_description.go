@@ -0,0 +1,343 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/hex"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// TransactionDescription is a structured, JSON serializable description of a transaction intended for
+// signing UIs. Entity IDs are rendered in `shard.realm.num` form, amounts carry their unit and the body
+// contains the decoded fields of the transaction type, keyed by their protobuf JSON names.
+type TransactionDescription struct {
+	Type              string                 `json:"type"`
+	Payer             string                 `json:"payer,omitempty"`
+	TransactionID     string                 `json:"transactionId,omitempty"`
+	NodeAccountIDs    []string               `json:"nodeAccountIds"`
+	MaxTransactionFee DescribedAmount        `json:"maxTransactionFee"`
+	ValidDuration     string                 `json:"validDuration"`
+	Memo              string                 `json:"memo"`
+	Body              map[string]interface{} `json:"body"`
+}
+
+// DescribedAmount is an amount within a TransactionDescription together with its unit
+type DescribedAmount struct {
+	// Value in the smallest denomination, tinybars for hbar
+	Value int64 `json:"value"`
+	// Decimals of the unit, omitted for tokens whose decimals are not known
+	Decimals *uint32 `json:"decimals,omitempty"`
+	// Unit is "hbar" or the token ID
+	Unit string `json:"unit"`
+	// Formatted is the value scaled by the decimals of the unit
+	Formatted string `json:"formatted"`
+}
+
+// Fields that hold hbar amounts in tinybars
+var describedHbarFields = map[protoreflect.FullName]bool{
+	"proto.CryptoCreateTransactionBody.initialBalance":   true,
+	"proto.ContractCreateTransactionBody.initialBalance": true,
+	"proto.ContractCallTransactionBody.amount":           true,
+	"proto.CryptoAllowance.amount":                       true,
+	"proto.EthereumTransactionBody.max_gas_allowance":    true,
+	"proto.SchedulableTransactionBody.transactionFee":    true,
+}
+
+// TransactionDescribe returns a structured description of the transaction
+func TransactionDescribe(tx TransactionInterface) (TransactionDescription, error) {
+	return TransactionDescribeWithABI(tx, nil)
+}
+
+// TransactionDescribeWithABI returns a structured description of the transaction, decoding contract call
+// and constructor parameters with the given ABI
+func TransactionDescribeWithABI(tx TransactionInterface, abi *ABI) (TransactionDescription, error) {
+	baseTx := tx.getBaseTransaction()
+
+	description := TransactionDescription{
+		Type:           tx.getName(),
+		NodeAccountIDs: make([]string, 0),
+		ValidDuration:  baseTx.GetTransactionValidDuration().String(),
+		Memo:           baseTx.GetTransactionMemo(),
+		Body:           make(map[string]interface{}),
+	}
+
+	transactionID := baseTx.GetTransactionID()
+	if transactionID.AccountID != nil {
+		description.Payer = transactionID.AccountID.String()
+		description.TransactionID = transactionID.String()
+	}
+
+	for _, nodeAccountID := range baseTx.GetNodeAccountIDs() {
+		description.NodeAccountIDs = append(description.NodeAccountIDs, nodeAccountID.String())
+	}
+
+	fee := baseTx.transactionFee
+	if fee == 0 {
+		fee = baseTx.defaultMaxTransactionFee
+	}
+	description.MaxTransactionFee = _DescribeHbar(int64(fee))
+
+	body := tx.build().ProtoReflect()
+	if data := body.WhichOneof(body.Descriptor().Oneofs().ByName("data")); data != nil {
+		describer := _TransactionDescriber{abi: abi}
+		if fields, ok := describer._Message(body.Get(data).Message()).(map[string]interface{}); ok {
+			description.Body = fields
+		}
+	}
+
+	return description, nil
+}
+
+type _TransactionDescriber struct {
+	abi *ABI
+}
+
+func (d _TransactionDescriber) _Message(msg protoreflect.Message) interface{} { // nolint
+	switch pb := msg.Interface().(type) {
+	case *services.AccountID:
+		return _AccountIDFromProtobuf(pb).String()
+	case *services.TokenID:
+		return _TokenIDFromProtobuf(pb).String()
+	case *services.ContractID:
+		return _ContractIDFromProtobuf(pb).String()
+	case *services.FileID:
+		return _FileIDFromProtobuf(pb).String()
+	case *services.TopicID:
+		return _TopicIDFromProtobuf(pb).String()
+	case *services.ScheduleID:
+		return _ScheduleIDFromProtobuf(pb).String()
+	case *services.Timestamp:
+		return _TimeFromProtobuf(pb).UTC().Format(time.RFC3339Nano)
+	case *services.Duration:
+		return _DurationFromProtobuf(pb).String()
+	case *services.Key:
+		if key, err := _KeyFromProtobuf(pb); err == nil {
+			return key.String()
+		}
+	case *services.TransferList:
+		transfers := make([]interface{}, 0)
+		for _, accountAmount := range pb.GetAccountAmounts() {
+			transfers = append(transfers, d._AccountAmount(accountAmount, _DescribeHbar(accountAmount.GetAmount())))
+		}
+		return map[string]interface{}{"accountAmounts": transfers}
+	case *services.TokenTransferList:
+		return d._TokenTransferList(pb)
+	case *services.FixedFee:
+		if pb.GetDenominatingTokenId() == nil {
+			return map[string]interface{}{"amount": _DescribeHbar(pb.GetAmount())}
+		}
+	}
+
+	if strings.HasPrefix(string(msg.Descriptor().FullName()), "google.protobuf.") && strings.HasSuffix(string(msg.Descriptor().Name()), "Value") {
+		value := msg.Descriptor().Fields().ByName("value")
+		return d._Value(value, msg.Get(value))
+	}
+
+	fields := make(map[string]interface{})
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		fields[fd.JSONName()] = d._Field(fd, v)
+		return true
+	})
+
+	return fields
+}
+
+func (d _TransactionDescriber) _Field(fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+	switch {
+	case fd.IsList():
+		list := v.List()
+		values := make([]interface{}, 0, list.Len())
+		for i := 0; i < list.Len(); i++ {
+			values = append(values, d._Value(fd, list.Get(i)))
+		}
+		// Token transfer lists are built from maps, sort them so the description is stable
+		if fd.Message() != nil && fd.Message().FullName() == "proto.TokenTransferList" {
+			sort.SliceStable(values, func(i, j int) bool {
+				return values[i].(map[string]interface{})["token"].(string) < values[j].(map[string]interface{})["token"].(string)
+			})
+		}
+		return values
+	case fd.IsMap():
+		values := make(map[string]interface{})
+		v.Map().Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+			values[key.String()] = d._Value(fd.MapValue(), value)
+			return true
+		})
+		return values
+	default:
+		return d._Value(fd, v)
+	}
+}
+
+func (d _TransactionDescriber) _Value(fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+	if describedHbarFields[fd.FullName()] {
+		if fd.Kind() == protoreflect.Uint64Kind {
+			return _DescribeHbar(int64(v.Uint()))
+		}
+		return _DescribeHbar(v.Int())
+	}
+
+	switch fd.FullName() {
+	case "proto.ContractCallTransactionBody.functionParameters":
+		if d.abi != nil {
+			if call := _DescribeCalldata(d.abi, v.Bytes()); call != nil {
+				return call
+			}
+		}
+	case "proto.ContractCreateTransactionBody.constructorParameters":
+		if d.abi != nil && d.abi.Constructor != nil {
+			if arguments, err := Decode(d.abi.Constructor.Inputs, v.Bytes()); err == nil {
+				return map[string]interface{}{"arguments": _DescribeABIValue(arguments)}
+			}
+		}
+	}
+
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return d._Message(v.Message())
+	case protoreflect.EnumKind:
+		if value := fd.Enum().Values().ByNumber(v.Enum()); value != nil {
+			return string(value.Name())
+		}
+		return int32(v.Enum())
+	case protoreflect.BytesKind:
+		return hex.EncodeToString(v.Bytes())
+	default:
+		return v.Interface()
+	}
+}
+
+func (d _TransactionDescriber) _AccountAmount(accountAmount *services.AccountAmount, amount DescribedAmount) map[string]interface{} {
+	transfer := map[string]interface{}{
+		"accountId": _AccountIDFromProtobuf(accountAmount.GetAccountID()).String(),
+		"amount":    amount,
+	}
+	if accountAmount.GetIsApproval() {
+		transfer["isApproval"] = true
+	}
+
+	return transfer
+}
+
+func (d _TransactionDescriber) _TokenTransferList(pb *services.TokenTransferList) map[string]interface{} {
+	tokenID := _TokenIDFromProtobuf(pb.GetToken())
+	var decimals *uint32
+	if pb.GetExpectedDecimals() != nil {
+		value := pb.GetExpectedDecimals().GetValue()
+		decimals = &value
+	}
+
+	list := map[string]interface{}{
+		"token": tokenID.String(),
+	}
+
+	if len(pb.GetTransfers()) > 0 {
+		transfers := make([]interface{}, 0, len(pb.GetTransfers()))
+		for _, accountAmount := range pb.GetTransfers() {
+			transfers = append(transfers, d._AccountAmount(accountAmount, _DescribeTokenAmount(*tokenID, accountAmount.GetAmount(), decimals)))
+		}
+		list["transfers"] = transfers
+	}
+
+	if len(pb.GetNftTransfers()) > 0 {
+		nftTransfers := make([]interface{}, 0, len(pb.GetNftTransfers()))
+		for _, nftTransfer := range pb.GetNftTransfers() {
+			nftTransfers = append(nftTransfers, d._Message(nftTransfer.ProtoReflect()))
+		}
+		list["nftTransfers"] = nftTransfers
+	}
+
+	return list
+}
+
+func _DescribeHbar(tinybar int64) DescribedAmount {
+	decimals := uint32(8)
+	return DescribedAmount{
+		Value:     tinybar,
+		Decimals:  &decimals,
+		Unit:      "hbar",
+		Formatted: _FormatWithDecimals(tinybar, decimals) + " " + HbarUnits.Hbar.Symbol(),
+	}
+}
+
+func _DescribeTokenAmount(tokenID TokenID, amount int64, decimals *uint32) DescribedAmount {
+	formatted := big.NewInt(amount).String()
+	if decimals != nil {
+		formatted = _FormatWithDecimals(amount, *decimals)
+	}
+
+	return DescribedAmount{
+		Value:     amount,
+		Decimals:  decimals,
+		Unit:      tokenID.String(),
+		Formatted: formatted,
+	}
+}
+
+// _FormatWithDecimals renders value / 10^decimals without trailing zeros
+func _FormatWithDecimals(value int64, decimals uint32) string {
+	scaled := new(big.Rat).SetFrac(big.NewInt(value), new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+	formatted := scaled.FloatString(int(decimals))
+	if strings.Contains(formatted, ".") {
+		formatted = strings.TrimRight(strings.TrimRight(formatted, "0"), ".")
+	}
+
+	return formatted
+}
+
+// _DescribeCalldata matches the selector of the calldata against the methods of the ABI and decodes the arguments
+func _DescribeCalldata(abi *ABI, data []byte) map[string]interface{} {
+	call, err := abi.DecodeCalldata(data)
+	if err != nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"method":    call.Method.Sig(),
+		"arguments": _DescribeABIValue(call.Args),
+	}
+}
+
+// _DescribeABIValue converts values decoded by the abi package into JSON friendly values
+func _DescribeABIValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case *big.Int:
+		return v.String()
+	case Address:
+		return v.String()
+	case []byte:
+		return "0x" + hex.EncodeToString(v)
+	case map[string]interface{}:
+		values := make(map[string]interface{}, len(v))
+		for name, item := range v {
+			values[name] = _DescribeABIValue(item)
+		}
+		return values
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() { // nolint
+	case reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			bytes := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(bytes), rv)
+			return "0x" + hex.EncodeToString(bytes)
+		}
+		fallthrough
+	case reflect.Slice:
+		values := make([]interface{}, 0, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			values = append(values, _DescribeABIValue(rv.Index(i).Interface()))
+		}
+		return values
+	}
+
+	return value
+}
@@ -0,0 +1,109 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitTransactionDescribeTransfer(t *testing.T) {
+	t.Parallel()
+
+	tokenID := TokenID{Token: 7}
+	tx, err := NewTransferTransaction().
+		AddHbarTransfer(AccountID{Account: 2}, NewHbar(-1.5)).
+		AddHbarTransfer(AccountID{Account: 3}, NewHbar(1.5)).
+		AddTokenTransferWithDecimals(tokenID, AccountID{Account: 2}, -250, 2).
+		AddTokenTransferWithDecimals(tokenID, AccountID{Account: 3}, 250, 2).
+		SetTransactionID(testTransactionID).
+		SetNodeAccountIDs([]AccountID{{Account: 4}}).
+		SetTransactionMemo("memo").
+		Freeze()
+	require.NoError(t, err)
+
+	description, err := TransactionDescribe(tx)
+	require.NoError(t, err)
+
+	assert.Equal(t, "TransferTransaction", description.Type)
+	assert.Equal(t, "0.0.3", description.Payer)
+	assert.Equal(t, testTransactionID.String(), description.TransactionID)
+	assert.Equal(t, []string{"0.0.4"}, description.NodeAccountIDs)
+	assert.Equal(t, "1 ℏ", description.MaxTransactionFee.Formatted)
+	assert.Equal(t, "memo", description.Memo)
+
+	data, err := json.Marshal(description.Body)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"transfers": {"accountAmounts": [
+			{"accountId": "0.0.2", "amount": {"value": -150000000, "decimals": 8, "unit": "hbar", "formatted": "-1.5 ℏ"}},
+			{"accountId": "0.0.3", "amount": {"value": 150000000, "decimals": 8, "unit": "hbar", "formatted": "1.5 ℏ"}}
+		]},
+		"tokenTransfers": [{
+			"token": "0.0.7",
+			"transfers": [
+				{"accountId": "0.0.2", "amount": {"value": -250, "decimals": 2, "unit": "0.0.7", "formatted": "-2.5"}},
+				{"accountId": "0.0.3", "amount": {"value": 250, "decimals": 2, "unit": "0.0.7", "formatted": "2.5"}}
+			]
+		}]
+	}`, string(data))
+}
+
+func TestUnitTransactionDescribeContractCallWithABI(t *testing.T) {
+	t.Parallel()
+
+	abi, err := NewABIFromList([]string{"function transfer(address to, uint256 amount) returns (bool)"})
+	require.NoError(t, err)
+
+	calldata, err := abi.GetMethod("transfer").Encode(map[string]interface{}{
+		"to":     "0x000000000000000000000000000000000000abcd",
+		"amount": big.NewInt(42),
+	})
+	require.NoError(t, err)
+
+	tx := NewContractExecuteTransaction().
+		SetContractID(ContractID{Contract: 9}).
+		SetGas(100000).
+		SetPayableAmount(NewHbar(2)).
+		SetFunctionParameters(calldata)
+
+	description, err := TransactionDescribeWithABI(tx, abi)
+	require.NoError(t, err)
+
+	assert.Equal(t, "0.0.9", description.Body["contractID"])
+	assert.Equal(t, "2 ℏ", description.Body["amount"].(DescribedAmount).Formatted)
+	assert.Equal(t, map[string]interface{}{
+		"method": "transfer(address,uint256)",
+		"arguments": map[string]interface{}{
+			"to":     "0x000000000000000000000000000000000000ABcD",
+			"amount": "42",
+		},
+	}, description.Body["functionParameters"])
+}
+
+func TestUnitTransactionDescribeAccountCreate(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyFromString(mockPrivateKey)
+	require.NoError(t, err)
+
+	tx := NewAccountCreateTransaction().
+		SetKeyWithoutAlias(key.PublicKey()).
+		SetInitialBalance(NewHbar(10)).
+		SetAccountMemo("account")
+
+	description, err := TransactionDescribe(tx)
+	require.NoError(t, err)
+
+	assert.Equal(t, key.PublicKey().String(), description.Body["key"])
+	assert.Equal(t, "10 ℏ", description.Body["initialBalance"].(DescribedAmount).Formatted)
+	assert.Equal(t, "account", description.Body["memo"])
+	assert.Equal(t, "2191h40m0s", description.Body["autoRenewPeriod"])
+}
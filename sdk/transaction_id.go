@@ -198,6 +198,34 @@ func (id TransactionID) ToBytes() []byte {
 	return data
 }
 
+// MarshalJSON implements the encoding.JSON interface.
+func (id TransactionID) MarshalJSON() ([]byte, error) {
+	return _StringToJSON(id.String())
+}
+
+// UnmarshalJSON implements the encoding.JSON interface.
+func (id *TransactionID) UnmarshalJSON(data []byte) error {
+	value, err := _StringFromJSON(data)
+	if err != nil {
+		return err
+	}
+
+	// An empty string is the encoding of a TransactionID without an account or valid start
+	if value == "" {
+		*id = TransactionID{}
+		return nil
+	}
+
+	result, err := TransactionIdFromString(value)
+	if err != nil {
+		return err
+	}
+
+	*id = result
+
+	return nil
+}
+
 // TransactionIDFromBytes constructs a TransactionID from a byte array
 func TransactionIDFromBytes(data []byte) (TransactionID, error) {
 	if data == nil {
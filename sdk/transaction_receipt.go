@@ -48,18 +48,12 @@ func (receipt *TransactionReceipt) _ToMap() map[string]interface{} {
 	}
 
 	// The real ExchangeRate struct has cents and ExpirationTime fields as private, so they can't be marshalled directly
-	type ExchangeRateJSON struct {
-		Hbars          int32  `json:"hbars"`
-		Cents          int32  `json:"cents"`
-		ExpirationTime string `json:"expirationTime"`
-	}
-
 	const layout = "2006-01-02T15:04:05.000Z"
 	if receipt.ExchangeRate != nil {
 		expiration := time.Unix(receipt.ExchangeRate.expirationTime.Seconds, 0)
 		expirationStr := expiration.UTC().Format(layout)
 
-		m["exchangeRate"] = ExchangeRateJSON{
+		m["exchangeRate"] = _ExchangeRateJSON{
 			Hbars:          receipt.ExchangeRate.Hbars,
 			Cents:          receipt.ExchangeRate.cents,
 			ExpirationTime: expirationStr,
@@ -69,7 +63,7 @@ func (receipt *TransactionReceipt) _ToMap() map[string]interface{} {
 		expiration := time.Unix(receipt.NextExchangeRate.expirationTime.Seconds, 0)
 		expirationStr := expiration.UTC().Format(layout)
 
-		m["nextExchangeRate"] = ExchangeRateJSON{
+		m["nextExchangeRate"] = _ExchangeRateJSON{
 			Hbars:          receipt.NextExchangeRate.Hbars,
 			Cents:          receipt.NextExchangeRate.cents,
 			ExpirationTime: expirationStr,
@@ -104,6 +98,105 @@ func (receipt TransactionReceipt) MarshalJSON() ([]byte, error) {
 	return json.Marshal(receipt._ToMap())
 }
 
+type _ExchangeRateJSON struct {
+	Hbars          int32  `json:"hbars"`
+	Cents          int32  `json:"cents"`
+	ExpirationTime string `json:"expirationTime"`
+}
+
+func (exchangeRate *_ExchangeRateJSON) _ToExchangeRate() (*ExchangeRate, error) {
+	if exchangeRate == nil {
+		return nil, nil
+	}
+
+	expiration, err := time.Parse("2006-01-02T15:04:05.000Z", exchangeRate.ExpirationTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExchangeRate{
+		Hbars:          exchangeRate.Hbars,
+		cents:          exchangeRate.Cents,
+		expirationTime: &services.TimestampSeconds{Seconds: expiration.Unix()},
+	}, nil
+}
+
+func _StatusFromString(name string) (Status, error) {
+	code, ok := services.ResponseCodeEnum_value[name]
+	if !ok {
+		return 0, fmt.Errorf("unknown status %q", name)
+	}
+
+	return Status(code), nil
+}
+
+// UnmarshalJSON parses the JSON representation produced by MarshalJSON.
+func (receipt *TransactionReceipt) UnmarshalJSON(data []byte) error {
+	var obj struct {
+		Status                  string               `json:"status"`
+		ExchangeRate            *_ExchangeRateJSON   `json:"exchangeRate"`
+		NextExchangeRate        *_ExchangeRateJSON   `json:"nextExchangeRate"`
+		TopicID                 *TopicID             `json:"topicId"`
+		FileID                  *FileID              `json:"fileId"`
+		ContractID              *ContractID          `json:"contractId"`
+		AccountID               *AccountID           `json:"accountId"`
+		TokenID                 *TokenID             `json:"tokenId"`
+		ScheduleID              *ScheduleID          `json:"scheduleId"`
+		ScheduledTransactionID  *TransactionID       `json:"scheduledTransactionId"`
+		TopicSequenceNumber     uint64               `json:"topicSequenceNumber"`
+		TopicRunningHash        string               `json:"topicRunningHash"`
+		TopicRunningHashVersion uint64               `json:"topicRunningHashVersion"`
+		TotalSupply             uint64               `json:"totalSupply"`
+		SerialNumbers           []int64              `json:"serialNumbers"`
+		NodeID                  uint64               `json:"nodeId"`
+		Children                []TransactionReceipt `json:"children"`
+		Duplicates              []TransactionReceipt `json:"duplicates"`
+	}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+
+	status, err := _StatusFromString(obj.Status)
+	if err != nil {
+		return err
+	}
+	exchangeRate, err := obj.ExchangeRate._ToExchangeRate()
+	if err != nil {
+		return err
+	}
+	nextExchangeRate, err := obj.NextExchangeRate._ToExchangeRate()
+	if err != nil {
+		return err
+	}
+	topicRunningHash, err := hex.DecodeString(obj.TopicRunningHash)
+	if err != nil {
+		return err
+	}
+
+	*receipt = TransactionReceipt{
+		Status:                  status,
+		ExchangeRate:            exchangeRate,
+		NextExchangeRate:        nextExchangeRate,
+		TopicID:                 obj.TopicID,
+		FileID:                  obj.FileID,
+		ContractID:              obj.ContractID,
+		AccountID:               obj.AccountID,
+		TokenID:                 obj.TokenID,
+		TopicSequenceNumber:     obj.TopicSequenceNumber,
+		TopicRunningHash:        topicRunningHash,
+		TopicRunningHashVersion: obj.TopicRunningHashVersion,
+		TotalSupply:             obj.TotalSupply,
+		ScheduleID:              obj.ScheduleID,
+		ScheduledTransactionID:  obj.ScheduledTransactionID,
+		SerialNumbers:           obj.SerialNumbers,
+		NodeID:                  obj.NodeID,
+		Children:                obj.Children,
+		Duplicates:              obj.Duplicates,
+	}
+
+	return nil
+}
+
 func _TransactionReceiptFromProtobuf(protoResponse *services.TransactionGetReceiptResponse, transactionID *TransactionID) TransactionReceipt {
 	if protoResponse == nil {
 		return TransactionReceipt{}
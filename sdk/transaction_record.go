@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"sort"
+	"strconv"
 	"time"
 
 	jsoniter "github.com/json-iterator/go"
@@ -236,6 +237,255 @@ func (record TransactionRecord) MarshalJSON() ([]byte, error) {
 	return result, err
 }
 
+// UnmarshalJSON parses the JSON representation produced by MarshalJSON. The format does not carry the contract
+// call result, the deprecated allowance lists or token decimals, and timestamps are kept with millisecond precision.
+func (record *TransactionRecord) UnmarshalJSON(data []byte) error { // nolint
+	var json = jsoniter.ConfigCompatibleWithStandardLibrary
+	var obj struct {
+		TransactionHash string        `json:"transactionHash"`
+		TransactionID   TransactionID `json:"transactionId"`
+		ScheduleRef     ScheduleID    `json:"scheduleRef"`
+		TransactionMemo string        `json:"transactionMemo"`
+		TransactionFee  string        `json:"transactionFee"`
+		Transfers       []struct {
+			AccountID  AccountID `json:"accountId"`
+			Amount     string    `json:"amount"`
+			IsApproved bool      `json:"isApproved"`
+		} `json:"transfers"`
+		TokenTransfers map[string]map[string]string `json:"tokenTransfers"`
+		NftTransfers   map[string][]struct {
+			SenderAccountID   AccountID `json:"sender"`
+			ReceiverAccountID AccountID `json:"recipient"`
+			IsApproved        bool      `json:"isApproved"`
+			SerialNumber      int64     `json:"serial"`
+		} `json:"nftTransfers"`
+		CallResultIsCreate bool `json:"callResultIsCreate"`
+		AssessedCustomFees []struct {
+			FeeCollectorAccountID string   `json:"feeCollectorAccountId"`
+			TokenID               string   `json:"tokenId"`
+			Amount                string   `json:"amount"`
+			PayerAccountIDs       []string `json:"payerAccountIds"`
+		} `json:"assessedCustomFees"`
+		AutomaticTokenAssociations []struct {
+			TokenID   TokenID   `json:"tokenId"`
+			AccountID AccountID `json:"accountId"`
+		} `json:"automaticTokenAssociations"`
+		ConsensusTimestamp       string `json:"consensusTimestamp"`
+		ParentConsensusTimestamp string `json:"parentConsensusTimestamp"`
+		AliasKey                 string `json:"aliasKey"`
+		EthereumHash             string `json:"ethereumHash"`
+		PaidStakingRewards       []struct {
+			AccountID AccountID `json:"accountId"`
+			Amount    string    `json:"amount"`
+		} `json:"paidStakingRewards"`
+		PrngBytes             string              `json:"prngBytes"`
+		PrngNumber            *int32              `json:"prngNumber"`
+		EvmAddress            string              `json:"evmAddress"`
+		Receipt               TransactionReceipt  `json:"receipt"`
+		Children              []TransactionRecord `json:"children"`
+		Duplicates            []TransactionRecord `json:"duplicates"`
+		PendingAirdropRecords []struct {
+			PendingAirdropID struct {
+				Sender   string `json:"sender"`
+				Receiver string `json:"receiver"`
+				TokenID  string `json:"tokenId"`
+				NftID    string `json:"nftId"`
+			} `json:"pendingAirdropId"`
+			PendingAirdropAmount string `json:"pendingAirdropAmount"`
+		} `json:"pendingAirdropRecords"`
+	}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+
+	var err error
+	result := TransactionRecord{
+		Receipt:            obj.Receipt,
+		TransactionID:      obj.TransactionID,
+		ScheduleRef:        obj.ScheduleRef,
+		TransactionMemo:    obj.TransactionMemo,
+		CallResultIsCreate: obj.CallResultIsCreate,
+		PrngNumber:         obj.PrngNumber,
+		Children:           obj.Children,
+		Duplicates:         obj.Duplicates,
+	}
+
+	if result.TransactionHash, err = hex.DecodeString(obj.TransactionHash); err != nil {
+		return err
+	}
+	if result.EthereumHash, err = hex.DecodeString(obj.EthereumHash); err != nil {
+		return err
+	}
+	if result.PrngBytes, err = hex.DecodeString(obj.PrngBytes); err != nil {
+		return err
+	}
+	if result.EvmAddress, err = hex.DecodeString(obj.EvmAddress); err != nil {
+		return err
+	}
+
+	fee, err := strconv.ParseInt(obj.TransactionFee, 10, 64)
+	if err != nil {
+		return err
+	}
+	result.TransactionFee = HbarFromTinybar(fee)
+
+	if result.ConsensusTimestamp, err = time.Parse("2006-01-02T15:04:05.000Z", obj.ConsensusTimestamp); err != nil {
+		return err
+	}
+	if result.ParentConsensusTimestamp, err = time.Parse("2006-01-02T15:04:05.000Z", obj.ParentConsensusTimestamp); err != nil {
+		return err
+	}
+
+	if obj.AliasKey != "" && obj.AliasKey != "<nil>" {
+		aliasKey, err := PublicKeyFromString(obj.AliasKey)
+		if err != nil {
+			return err
+		}
+		result.AliasKey = &aliasKey
+	}
+
+	for _, transfer := range obj.Transfers {
+		amount, err := strconv.ParseInt(transfer.Amount, 10, 64)
+		if err != nil {
+			return err
+		}
+		result.Transfers = append(result.Transfers, Transfer{
+			AccountID:  transfer.AccountID,
+			Amount:     HbarFromTinybar(amount),
+			IsApproved: transfer.IsApproved,
+		})
+	}
+
+	result.TokenTransfers = make(map[TokenID][]TokenTransfer, len(obj.TokenTransfers))
+	for token, accounts := range obj.TokenTransfers {
+		tokenID, err := TokenIDFromString(token)
+		if err != nil {
+			return err
+		}
+		for account, value := range accounts {
+			accountID, err := AccountIDFromString(account)
+			if err != nil {
+				return err
+			}
+			amount, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return err
+			}
+			result.TokenTransfers[tokenID] = append(result.TokenTransfers[tokenID], TokenTransfer{
+				AccountID: accountID,
+				Amount:    amount,
+			})
+		}
+		sort.Slice(result.TokenTransfers[tokenID], func(i, j int) bool {
+			return result.TokenTransfers[tokenID][i].AccountID.Compare(result.TokenTransfers[tokenID][j].AccountID) < 0
+		})
+	}
+
+	result.NftTransfers = make(map[TokenID][]_TokenNftTransfer, len(obj.NftTransfers))
+	for token, transfers := range obj.NftTransfers {
+		tokenID, err := TokenIDFromString(token)
+		if err != nil {
+			return err
+		}
+		for _, transfer := range transfers {
+			result.NftTransfers[tokenID] = append(result.NftTransfers[tokenID], _TokenNftTransfer{
+				SenderAccountID:   transfer.SenderAccountID,
+				ReceiverAccountID: transfer.ReceiverAccountID,
+				SerialNumber:      transfer.SerialNumber,
+				IsApproved:        transfer.IsApproved,
+			})
+		}
+	}
+
+	for _, fee := range obj.AssessedCustomFees {
+		assessedFee := AssessedCustomFee{}
+		if assessedFee.Amount, err = strconv.ParseInt(fee.Amount, 10, 64); err != nil {
+			return err
+		}
+		if assessedFee.FeeCollectorAccountId, err = _OptionalAccountIDFromString(fee.FeeCollectorAccountID); err != nil {
+			return err
+		}
+		if fee.TokenID != "" {
+			tokenID, err := TokenIDFromString(fee.TokenID)
+			if err != nil {
+				return err
+			}
+			assessedFee.TokenID = &tokenID
+		}
+		for _, payer := range fee.PayerAccountIDs {
+			payerAccountID, err := AccountIDFromString(payer)
+			if err != nil {
+				return err
+			}
+			assessedFee.PayerAccountIDs = append(assessedFee.PayerAccountIDs, &payerAccountID)
+		}
+		result.AssessedCustomFees = append(result.AssessedCustomFees, assessedFee)
+	}
+
+	for _, association := range obj.AutomaticTokenAssociations {
+		tokenID, accountID := association.TokenID, association.AccountID
+		result.AutomaticTokenAssociations = append(result.AutomaticTokenAssociations, TokenAssociation{
+			TokenID:   &tokenID,
+			AccountID: &accountID,
+		})
+	}
+
+	if len(obj.PaidStakingRewards) > 0 {
+		result.PaidStakingRewards = make(map[AccountID]Hbar, len(obj.PaidStakingRewards))
+	}
+	for _, reward := range obj.PaidStakingRewards {
+		amount, err := strconv.ParseInt(reward.Amount, 10, 64)
+		if err != nil {
+			return err
+		}
+		result.PaidStakingRewards[reward.AccountID] = HbarFromTinybar(amount)
+	}
+
+	for _, airdrop := range obj.PendingAirdropRecords {
+		pendingAirdrop := PendingAirdropRecord{}
+		if pendingAirdrop.pendingAirdropAmount, err = strconv.ParseUint(airdrop.PendingAirdropAmount, 10, 64); err != nil {
+			return err
+		}
+		if pendingAirdrop.pendingAirdropId.sender, err = _OptionalAccountIDFromString(airdrop.PendingAirdropID.Sender); err != nil {
+			return err
+		}
+		if pendingAirdrop.pendingAirdropId.receiver, err = _OptionalAccountIDFromString(airdrop.PendingAirdropID.Receiver); err != nil {
+			return err
+		}
+		if airdrop.PendingAirdropID.TokenID != "" {
+			tokenID, err := TokenIDFromString(airdrop.PendingAirdropID.TokenID)
+			if err != nil {
+				return err
+			}
+			pendingAirdrop.pendingAirdropId.tokenID = &tokenID
+		}
+		if airdrop.PendingAirdropID.NftID != "" {
+			nftID, err := NftIDFromString(airdrop.PendingAirdropID.NftID)
+			if err != nil {
+				return err
+			}
+			pendingAirdrop.pendingAirdropId.nftID = &nftID
+		}
+		result.PendingAirdropRecords = append(result.PendingAirdropRecords, pendingAirdrop)
+	}
+
+	*record = result
+	return nil
+}
+
+func _OptionalAccountIDFromString(value string) (*AccountID, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	accountID, err := AccountIDFromString(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountID, nil
+}
+
 // GetContractExecuteResult returns the ContractFunctionResult if the transaction was a contract call
 func (record TransactionRecord) GetContractExecuteResult() (ContractFunctionResult, error) {
 	if record.CallResult == nil || record.CallResultIsCreate {
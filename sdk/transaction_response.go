@@ -34,6 +34,29 @@ func (response TransactionResponse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(obj)
 }
 
+// UnmarshalJSON parses the JSON representation produced by MarshalJSON.
+func (response *TransactionResponse) UnmarshalJSON(data []byte) error {
+	var json = jsoniter.ConfigCompatibleWithStandardLibrary
+	var obj struct {
+		NodeID        AccountID     `json:"nodeID"`
+		Hash          string        `json:"hash"`
+		TransactionID TransactionID `json:"transactionID"`
+	}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+
+	hash, err := hex.DecodeString(obj.Hash)
+	if err != nil {
+		return err
+	}
+
+	response.NodeID = obj.NodeID
+	response.Hash = hash
+	response.TransactionID = obj.TransactionID
+	return nil
+}
+
 // retryTransaction is a helper function to retry a transaction that was throttled
 func retryTransaction(client *Client, transaction TransactionInterface) (TransactionReceipt, error) {
 	resp, err := TransactionExecute(transaction, client)
@@ -102,6 +125,19 @@ func (response TransactionResponse) GetRecordQuery() *TransactionRecordQuery {
 		SetNodeAccountIDs([]AccountID{response.NodeID})
 }
 
+// GetMirrorRecord retrieves the record for the transaction from the mirror node, which keeps it after the nodes
+// no longer do.
+func (response TransactionResponse) GetMirrorRecord(client *Client) (TransactionRecord, error) {
+	return response.GetMirrorRecordQuery().Execute(client)
+}
+
+// GetMirrorRecordQuery retrieves the mirror node record query for the transaction
+func (response TransactionResponse) GetMirrorRecordQuery() *MirrorTransactionRecordQuery {
+	return NewMirrorTransactionRecordQuery().
+		SetTransactionID(response.TransactionID).
+		SetIncludeChildren(response.IncludeChildReceipts)
+}
+
 // SetValidateStatus sets the validate status for the transaction
 func (response TransactionResponse) SetValidateStatus(validate bool) *TransactionResponse {
 	response.ValidateStatus = validate
@@ -0,0 +1,282 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// Limits enforced by the network during precheck
+const (
+	maxMemoBytes                = 100
+	maxTokenNameBytes           = 100
+	maxTokenSymbolBytes         = 100
+	maxTransactionBytes         = 6144
+	maxTransferListSize         = 10
+	maxTokenTransferListSize    = 10
+	maxNftTransferListSize      = 10
+	maxNftMintBatchSize         = 10
+	maxNftMetadataBytes         = 100
+	minTransactionValidDuration = 15 * time.Second
+	maxTransactionValidDuration = 180 * time.Second
+)
+
+// ErrTransactionValidation is a single violation reported by Transaction.Validate.
+// Status is the precheck status the network would respond with for the same problem.
+type ErrTransactionValidation struct {
+	Status  Status
+	Message string
+}
+
+// Error() implements the Error interface
+func (e ErrTransactionValidation) Error() string {
+	return fmt.Sprintf("local validation failed with %s: %s", e.Status.String(), e.Message)
+}
+
+func _NewErrTransactionValidation(status Status, format string, a ...interface{}) ErrTransactionValidation {
+	return ErrTransactionValidation{Status: status, Message: fmt.Sprintf(format, a...)}
+}
+
+// Validate runs the network precheck rules that can be evaluated offline against the transaction
+// and returns every violation found. An empty result means the transaction passed all local checks.
+// Signatures are only checked on frozen transactions; use ValidateWith when the client operator is the
+// payer and will sign during Execute.
+//
+// Besides the common rules, the body is checked for the memos of accounts, contracts, files, schedules,
+// tokens and topics, the names and symbols of tokens, the NFT metadata of mints and the transfer lists of
+// transfers and airdrops. Other transaction types only get the common rules.
+func (tx *Transaction[T]) Validate() []ErrTransactionValidation {
+	return tx.ValidateWith(nil)
+}
+
+// ValidateWith is like Validate, but counts the client operator as a signer when it is the payer
+// of the transaction, since Execute will add the operator signature.
+func (tx *Transaction[T]) ValidateWith(client *Client) []ErrTransactionValidation {
+	violations := make([]ErrTransactionValidation, 0)
+
+	violations = append(violations, _ValidateMemo("transaction memo", tx.memo)...)
+
+	validDuration := tx.GetTransactionValidDuration()
+	if validDuration < minTransactionValidDuration || validDuration > maxTransactionValidDuration {
+		violations = append(violations, _NewErrTransactionValidation(StatusInvalidTransactionDuration,
+			"transaction valid duration %s is outside of %s..%s", validDuration, minTransactionValidDuration, maxTransactionValidDuration))
+	}
+
+	if tx.transactionIDs._Length() > 0 {
+		transactionID := tx.GetTransactionID()
+		if transactionID.ValidStart != nil && transactionID.ValidStart.Add(validDuration).Before(time.Now()) {
+			violations = append(violations, _NewErrTransactionValidation(StatusTransactionExpired,
+				"transaction valid start %s plus valid duration %s is in the past", transactionID.ValidStart.UTC().Format(time.RFC3339Nano), validDuration))
+		}
+	}
+
+	if size := tx._EstimateSize(); size > maxTransactionBytes {
+		violations = append(violations, _NewErrTransactionValidation(StatusTransactionOversize,
+			"transaction size of %d bytes exceeds the limit of %d bytes", size, maxTransactionBytes))
+	}
+
+	if tx.IsFrozen() {
+		violations = append(violations, tx._ValidatePayerSignature(client)...)
+	}
+
+	return append(violations, tx.childTransaction.validateBody()...)
+}
+
+// No-op for every transaction without type specific checks
+func (tx *Transaction[T]) validateBody() []ErrTransactionValidation {
+	return nil
+}
+
+// _ValidatePayerSignature checks the payer signature is present or will be added by the client operator.
+// The key of any other payer is unknown offline, so a transaction which is signed but not by the operator
+// is reported with StatusUnknown rather than accepted.
+func (tx *Transaction[T]) _ValidatePayerSignature(client *Client) []ErrTransactionValidation {
+	payer := tx.GetTransactionID().AccountID
+	if client != nil && client.operator != nil && payer != nil && client.operator.accountID._Equals(*payer) {
+		// Execute signs with the operator key when it isn't already present
+		return nil
+	}
+
+	if len(tx.publicKeys) == 0 {
+		return []ErrTransactionValidation{_NewErrTransactionValidation(StatusInvalidSignature,
+			"transaction is not signed by the payer account %s", payer)}
+	}
+
+	return []ErrTransactionValidation{_NewErrTransactionValidation(StatusUnknown,
+		"payer signature unknown, the key of the payer account %s can't be checked offline", payer)}
+}
+
+// _EstimateSize returns the size of the largest serialized transaction this transaction would be sent as,
+// including the signatures that are only attached when the transaction is built.
+func (tx *Transaction[T]) _EstimateSize() int {
+	if !tx.IsFrozen() {
+		bodyBytes, err := protobuf.Marshal(tx.childTransaction.build())
+		if err != nil {
+			return 0
+		}
+		return _TransactionSize(&services.SignedTransaction{BodyBytes: bodyBytes})
+	}
+
+	size := 0
+	for i := 0; i < tx.signedTransactions._Length(); i++ {
+		signedTx := tx.signedTransactions._Get(i).(*services.SignedTransaction)
+		sigPairs := append([]*services.SignaturePair{}, signedTx.GetSigMap().GetSigPair()...)
+
+		for index, key := range tx.publicKeys {
+			if tx.transactionSigners[index] == nil || _SigPairsContainKey(sigPairs, key) {
+				continue
+			}
+			sigPairs = append(sigPairs, key._ToSignaturePairProtobuf(make([]byte, 64)))
+		}
+
+		current := _TransactionSize(&services.SignedTransaction{
+			BodyBytes: signedTx.GetBodyBytes(),
+			SigMap:    &services.SignatureMap{SigPair: sigPairs},
+		})
+		if current > size {
+			size = current
+		}
+	}
+
+	return size
+}
+
+func _TransactionSize(signedTx *services.SignedTransaction) int {
+	return protobuf.Size(&services.Transaction{
+		SignedTransactionBytes: make([]byte, protobuf.Size(signedTx)),
+	})
+}
+
+func _SigPairsContainKey(sigPairs []*services.SignaturePair, key PublicKey) bool {
+	var keyBytes []byte
+	switch {
+	case key.ed25519PublicKey != nil:
+		keyBytes = key.ed25519PublicKey.keyData
+	case key.ecdsaPublicKey != nil:
+		keyBytes = key.ecdsaPublicKey._BytesRaw()
+	}
+
+	for _, sigPair := range sigPairs {
+		if bytes.Equal(sigPair.GetPubKeyPrefix(), keyBytes) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func _ValidateMemo(name string, memo string) []ErrTransactionValidation {
+	violations := make([]ErrTransactionValidation, 0)
+	if len(memo) > maxMemoBytes {
+		violations = append(violations, _NewErrTransactionValidation(StatusMemoTooLong,
+			"%s is %d bytes, the limit is %d bytes", name, len(memo), maxMemoBytes))
+	}
+	if strings.ContainsRune(memo, 0) {
+		violations = append(violations, _NewErrTransactionValidation(StatusInvalidZeroByteInString,
+			"%s contains a zero byte", name))
+	}
+
+	return violations
+}
+
+func _ValidateNftMetadata(metadata [][]byte) []ErrTransactionValidation {
+	violations := make([]ErrTransactionValidation, 0)
+	if len(metadata) > maxNftMintBatchSize {
+		violations = append(violations, _NewErrTransactionValidation(StatusBatchSizeLimitExceeded,
+			"%d NFT metadata are minted, the limit is %d", len(metadata), maxNftMintBatchSize))
+	}
+	for i, meta := range metadata {
+		if len(meta) > maxNftMetadataBytes {
+			violations = append(violations, _NewErrTransactionValidation(StatusMetadataTooLong,
+				"NFT metadata %d is %d bytes, the limit is %d bytes", i, len(meta), maxNftMetadataBytes))
+		}
+	}
+
+	return violations
+}
+
+func _ValidateHbarTransfers(hbarTransfers []*_HbarTransfer) []ErrTransactionValidation {
+	violations := make([]ErrTransactionValidation, 0)
+	if len(hbarTransfers) > maxTransferListSize {
+		violations = append(violations, _NewErrTransactionValidation(StatusTransferListSizeLimitExceeded,
+			"hbar transfer list has %d entries, the limit is %d", len(hbarTransfers), maxTransferListSize))
+	}
+
+	violations = append(violations, _ValidateAccountAmounts("hbar transfers", hbarTransfers, StatusInvalidAccountAmounts)...)
+
+	return violations
+}
+
+func _ValidateTokenTransfers(tokenTransfers map[TokenID]*_TokenTransfer, nftTransfers map[TokenID][]*_TokenNftTransfer) []ErrTransactionValidation {
+	violations := make([]ErrTransactionValidation, 0)
+
+	tokenIDs := make([]TokenID, 0, len(tokenTransfers))
+	for tokenID := range tokenTransfers {
+		tokenIDs = append(tokenIDs, tokenID)
+	}
+	sort.Slice(tokenIDs, func(i, j int) bool {
+		return tokenIDs[i].Compare(tokenIDs[j]) < 0
+	})
+
+	count := 0
+	for _, tokenID := range tokenIDs {
+		tokenTransfer := tokenTransfers[tokenID]
+		count += len(tokenTransfer.Transfers)
+		if len(tokenTransfer.Transfers) == 0 && len(nftTransfers[tokenID]) == 0 {
+			violations = append(violations, _NewErrTransactionValidation(StatusEmptyTokenTransferAccountAmounts,
+				"token %s has no transfers", tokenID.String()))
+		}
+
+		violations = append(violations, _ValidateAccountAmounts(
+			fmt.Sprintf("transfers of token %s", tokenID.String()), tokenTransfer.Transfers, StatusTransfersNotZeroSumForToken)...)
+	}
+	if count > maxTokenTransferListSize {
+		violations = append(violations, _NewErrTransactionValidation(StatusTokenTransferListSizeLimitExceeded,
+			"token transfer lists have %d entries, the limit is %d", count, maxTokenTransferListSize))
+	}
+
+	nftCount := 0
+	for _, transfers := range nftTransfers {
+		nftCount += len(transfers)
+	}
+	if nftCount > maxNftTransferListSize {
+		violations = append(violations, _NewErrTransactionValidation(StatusBatchSizeLimitExceeded,
+			"NFT transfer lists have %d entries, the limit is %d", nftCount, maxNftTransferListSize))
+	}
+
+	return violations
+}
+
+func _ValidateAccountAmounts(name string, transfers []*_HbarTransfer, sumStatus Status) []ErrTransactionValidation {
+	violations := make([]ErrTransactionValidation, 0)
+	seen := make(map[string]bool, len(transfers))
+	var sum int64
+
+	for _, transfer := range transfers {
+		sum += transfer.Amount.AsTinybar()
+		if transfer.accountID == nil {
+			continue
+		}
+
+		account := transfer.accountID.String()
+		if seen[account] {
+			violations = append(violations, _NewErrTransactionValidation(StatusAccountRepeatedInAccountAmounts,
+				"account %s is repeated in %s", account, name))
+		}
+		seen[account] = true
+	}
+
+	if sum != 0 {
+		violations = append(violations, _NewErrTransactionValidation(sumStatus,
+			"%s sum to %d instead of zero", name, sum))
+	}
+
+	return violations
+}
@@ -0,0 +1,189 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _ValidationStatuses(violations []ErrTransactionValidation) []Status {
+	statuses := make([]Status, 0, len(violations))
+	for _, violation := range violations {
+		statuses = append(statuses, violation.Status)
+	}
+	return statuses
+}
+
+func TestUnitTransactionValidateValid(t *testing.T) {
+	t.Parallel()
+
+	privateKey, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	tx, err := NewTransferTransaction().
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-100)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(100)).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 2})).
+		SetNodeAccountIDs([]AccountID{{Account: 4}}).
+		Freeze()
+	require.NoError(t, err)
+	tx.Sign(privateKey)
+
+	// Without a client the key of the payer is unknown
+	violations, err := TransactionValidate(tx)
+	assert.Equal(t, []Status{StatusUnknown}, _ValidationStatuses(violations))
+	require.ErrorContains(t, err, "payer signature unknown")
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+	require.Empty(t, tx.ValidateWith(client))
+}
+
+func TestUnitTransactionValidateBaseRules(t *testing.T) {
+	t.Parallel()
+
+	tx, err := NewTransferTransaction().
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-100)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(100)).
+		SetTransactionMemo(strings.Repeat("a", 101)).
+		SetTransactionValidDuration(200 * time.Second).
+		SetTransactionID(testTransactionID).
+		SetNodeAccountIDs([]AccountID{{Account: 4}}).
+		Freeze()
+	require.NoError(t, err)
+
+	violations := tx.Validate()
+	assert.ElementsMatch(t, []Status{
+		StatusMemoTooLong,
+		StatusInvalidTransactionDuration,
+		StatusTransactionExpired,
+		StatusInvalidSignature,
+	}, _ValidationStatuses(violations))
+}
+
+func TestUnitTransactionValidateWithOperatorAsPayer(t *testing.T) {
+	t.Parallel()
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+
+	tx, err := NewTransferTransaction().
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-100)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(100)).
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		FreezeWith(client)
+	require.NoError(t, err)
+
+	assert.Equal(t, []Status{StatusInvalidSignature}, _ValidationStatuses(tx.Validate()))
+	assert.Empty(t, tx.ValidateWith(client))
+}
+
+func TestUnitTransactionValidateOversize(t *testing.T) {
+	t.Parallel()
+
+	tx := NewFileCreateTransaction().
+		SetContents(make([]byte, maxTransactionBytes))
+
+	assert.Equal(t, []Status{StatusTransactionOversize}, _ValidationStatuses(tx.Validate()))
+}
+
+func TestUnitTransferTransactionValidateBody(t *testing.T) {
+	t.Parallel()
+
+	tokenID := TokenID{Token: 5}
+	tx := NewTransferTransaction().
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-100)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(50)).
+		AddTokenTransfer(tokenID, AccountID{Account: 2}, -10).
+		AddTokenTransfer(tokenID, AccountID{Account: 3}, 9)
+	tx.hbarTransfers = append(tx.hbarTransfers, &_HbarTransfer{accountID: &AccountID{Account: 3}, Amount: HbarFromTinybar(50)})
+
+	assert.ElementsMatch(t, []Status{
+		StatusAccountRepeatedInAccountAmounts,
+		StatusTransfersNotZeroSumForToken,
+	}, _ValidationStatuses(tx.Validate()))
+
+	tx = NewTransferTransaction()
+	for i := 0; i < 11; i++ {
+		tx.AddTokenTransfer(tokenID, AccountID{Account: uint64(100 + i)}, 0)
+	}
+	tx.AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-1))
+
+	assert.ElementsMatch(t, []Status{
+		StatusInvalidAccountAmounts,
+		StatusTokenTransferListSizeLimitExceeded,
+	}, _ValidationStatuses(tx.Validate()))
+}
+
+func TestUnitTokenCreateTransactionValidateBody(t *testing.T) {
+	t.Parallel()
+
+	tx := NewTokenCreateTransaction().
+		SetTokenName(strings.Repeat("n", 101)).
+		SetTokenMemo("memo\x00")
+
+	assert.ElementsMatch(t, []Status{
+		StatusTokenNameTooLong,
+		StatusMissingTokenSymbol,
+		StatusInvalidZeroByteInString,
+	}, _ValidationStatuses(tx.Validate()))
+}
+
+func TestUnitNftTransferTransactionValidateBody(t *testing.T) {
+	t.Parallel()
+
+	tx := NewTransferTransaction()
+	for i := 0; i < 11; i++ {
+		tx.AddNftTransfer(NftID{TokenID: TokenID{Token: 5}, SerialNumber: int64(i + 1)}, AccountID{Account: 2}, AccountID{Account: 3})
+	}
+
+	assert.Equal(t, []Status{StatusBatchSizeLimitExceeded}, _ValidationStatuses(tx.Validate()))
+}
+
+func TestUnitTokenMintTransactionValidateBody(t *testing.T) {
+	t.Parallel()
+
+	metadata := make([][]byte, 11)
+	for i := range metadata {
+		metadata[i] = []byte{byte(i)}
+	}
+	metadata[3] = make([]byte, 101)
+
+	tx := NewTokenMintTransaction().
+		SetTokenID(TokenID{Token: 5}).
+		SetMetadatas(metadata)
+
+	assert.ElementsMatch(t, []Status{
+		StatusBatchSizeLimitExceeded,
+		StatusMetadataTooLong,
+	}, _ValidationStatuses(tx.Validate()))
+}
+
+func TestUnitMemoTransactionsValidateBody(t *testing.T) {
+	t.Parallel()
+
+	memo := strings.Repeat("m", 101)
+	for _, tx := range []TransactionInterface{
+		NewContractCreateTransaction().SetContractMemo(memo),
+		NewContractUpdateTransaction().SetContractMemo(memo),
+		NewFileCreateTransaction().SetMemo(memo),
+		NewFileUpdateTransaction().SetFileMemo(memo),
+		NewScheduleCreateTransaction().SetScheduleMemo(memo),
+	} {
+		violations, err := TransactionValidate(tx)
+		assert.Equal(t, []Status{StatusMemoTooLong}, _ValidationStatuses(violations), tx.getName())
+		require.Error(t, err)
+	}
+
+	violations, err := TransactionValidate(NewScheduleCreateTransaction())
+	assert.Empty(t, violations)
+	require.NoError(t, err)
+}
@@ -429,6 +429,11 @@ func (tx TransferTransaction) getName() string {
 	return "TransferTransaction"
 }
 
+func (tx TransferTransaction) validateBody() []ErrTransactionValidation {
+	violations := _ValidateHbarTransfers(tx.hbarTransfers)
+	return append(violations, _ValidateTokenTransfers(tx.tokenTransfers, tx.nftTransfers)...)
+}
+
 func (tx TransferTransaction) validateNetworkOnIDs(client *Client) error {
 	if client == nil || !client.autoValidateChecksums {
 		return nil
@@ -0,0 +1,232 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protowire"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+var errUnknownTransactionMethod = errors.New("`UnknownTransaction` requires a gRPC method to be set before it can be executed")
+var errUnknownTransactionNotSchedulable = errors.New("`UnknownTransaction` decoded from a transaction body can't be scheduled")
+
+// TransactionDecoder converts an UnknownTransaction into an application defined transaction.
+type TransactionDecoder func(tx UnknownTransaction) (TransactionInterface, error)
+
+var transactionDecoders = struct {
+	sync.RWMutex
+	body      map[int32]TransactionDecoder
+	scheduled map[int32]TransactionDecoder
+}{
+	body:      map[int32]TransactionDecoder{},
+	scheduled: map[int32]TransactionDecoder{},
+}
+
+// RegisterTransactionDecoder registers a decoder for the TransactionBody field with the given number.
+// TransactionFromBytes calls it instead of returning an UnknownTransaction when the body holds that field.
+func RegisterTransactionDecoder(fieldNumber int32, decoder TransactionDecoder) {
+	transactionDecoders.Lock()
+	defer transactionDecoders.Unlock()
+	transactionDecoders.body[fieldNumber] = decoder
+}
+
+// RegisterScheduledTransactionDecoder registers a decoder for the SchedulableTransactionBody field with the given
+// number, used when reading the scheduled transaction of a ScheduleInfo.
+func RegisterScheduledTransactionDecoder(fieldNumber int32, decoder TransactionDecoder) {
+	transactionDecoders.Lock()
+	defer transactionDecoders.Unlock()
+	transactionDecoders.scheduled[fieldNumber] = decoder
+}
+
+func _DecodeUnknownTransaction(tx UnknownTransaction) (TransactionInterface, error) {
+	// A body without any data is malformed rather than of a newer type
+	if tx.GetDataFieldNumber() == 0 {
+		if tx.scheduledBody != nil {
+			return nil, errUnrecognizedTransactionType
+		}
+		return nil, errFailedToDeserializeBytes
+	}
+
+	transactionDecoders.RLock()
+	decoder, ok := transactionDecoders.body[tx.GetDataFieldNumber()]
+	if tx.scheduledBody != nil {
+		decoder, ok = transactionDecoders.scheduled[tx.GetDataFieldNumber()]
+	}
+	transactionDecoders.RUnlock()
+
+	if !ok {
+		return tx, nil
+	}
+
+	return decoder(tx)
+}
+
+// UnknownTransaction is a transaction with a body type this version of the SDK does not know.
+// The original body is kept as is, so the transaction can be signed, have signatures added and be serialized
+// again without changing its bytes. It can be executed once the gRPC method of the new body type is set.
+type UnknownTransaction struct {
+	*Transaction[*UnknownTransaction]
+	body          *services.TransactionBody
+	scheduledBody *services.SchedulableTransactionBody
+	grpcMethod    string
+}
+
+func _UnknownTransactionFromProtobuf(tx Transaction[*UnknownTransaction], pb *services.TransactionBody) UnknownTransaction {
+	unknownTransaction := UnknownTransaction{
+		body: pb,
+	}
+
+	tx.childTransaction = &unknownTransaction
+	unknownTransaction.Transaction = &tx
+	return unknownTransaction
+}
+
+func _UnknownTransactionFromScheduledProtobuf(tx Transaction[*UnknownTransaction], pb *services.SchedulableTransactionBody) UnknownTransaction {
+	unknownTransaction := UnknownTransaction{
+		body:          &services.TransactionBody{},
+		scheduledBody: pb,
+	}
+
+	tx.childTransaction = &unknownTransaction
+	unknownTransaction.Transaction = &tx
+	return unknownTransaction
+}
+
+// GetTransactionBodyBytes returns the serialized body the transaction was decoded from, or the serialized
+// SchedulableTransactionBody for a scheduled transaction. Decoders built against newer protobuf definitions can
+// unmarshal it directly.
+func (tx *UnknownTransaction) GetTransactionBodyBytes() []byte {
+	var data []byte
+	var err error
+	if tx.scheduledBody != nil {
+		data, err = protobuf.Marshal(tx.scheduledBody)
+	} else {
+		data, err = protobuf.Marshal(tx.body)
+	}
+	if err != nil {
+		return make([]byte, 0)
+	}
+
+	return data
+}
+
+// GetDataFieldNumber returns the field number of the unknown body type, or 0 when the body has no data.
+func (tx *UnknownTransaction) GetDataFieldNumber() int32 {
+	number, _ := tx._UnknownData()
+	return int32(number)
+}
+
+// GetDataBytes returns the serialized message of the unknown body type.
+func (tx *UnknownTransaction) GetDataBytes() []byte {
+	_, data := tx._UnknownData()
+	return data
+}
+
+func (tx *UnknownTransaction) _UnknownData() (protowire.Number, []byte) {
+	var unknown []byte
+	if tx.scheduledBody != nil {
+		unknown = tx.scheduledBody.ProtoReflect().GetUnknown()
+	} else {
+		unknown = tx.body.ProtoReflect().GetUnknown()
+	}
+
+	for len(unknown) > 0 {
+		number, wireType, length := protowire.ConsumeTag(unknown)
+		if length < 0 {
+			return 0, nil
+		}
+		unknown = unknown[length:]
+
+		if wireType == protowire.BytesType {
+			data, length := protowire.ConsumeBytes(unknown)
+			if length < 0 {
+				return 0, nil
+			}
+			return number, data
+		}
+
+		length = protowire.ConsumeFieldValue(number, wireType, unknown)
+		if length < 0 {
+			return 0, nil
+		}
+		unknown = unknown[length:]
+	}
+
+	return 0, nil
+}
+
+// SetGrpcMethod sets the full gRPC method the transaction is submitted to, eg. "/proto.CryptoService/cryptoTransfer"
+func (tx *UnknownTransaction) SetGrpcMethod(method string) *UnknownTransaction {
+	tx.grpcMethod = method
+	return tx
+}
+
+// GetGrpcMethod returns the gRPC method the transaction is submitted to
+func (tx *UnknownTransaction) GetGrpcMethod() string {
+	return tx.grpcMethod
+}
+
+// ----------- Overridden functions ----------------
+
+func (tx UnknownTransaction) getName() string {
+	return "UnknownTransaction"
+}
+
+func (tx UnknownTransaction) build() *services.TransactionBody {
+	body := protobuf.Clone(tx.body).(*services.TransactionBody)
+	body.TransactionFee = tx.transactionFee
+	body.Memo = tx.Transaction.memo
+	body.TransactionValidDuration = _DurationToProtobuf(tx.GetTransactionValidDuration())
+	body.TransactionID = tx.transactionID._ToProtobuf()
+	body.NodeAccountID = nil
+
+	return body
+}
+
+func (tx UnknownTransaction) buildScheduled() (*services.SchedulableTransactionBody, error) {
+	if tx.scheduledBody == nil {
+		return nil, errUnknownTransactionNotSchedulable
+	}
+
+	body := protobuf.Clone(tx.scheduledBody).(*services.SchedulableTransactionBody)
+	body.TransactionFee = tx.transactionFee
+	body.Memo = tx.Transaction.memo
+
+	return body, nil
+}
+
+// The method is checked here rather than in Execute so that every path which submits the transaction,
+// eg. TransactionExecute, fails before the request is sent
+func (tx UnknownTransaction) getMethod(channel *_Channel) _Method {
+	return _Method{
+		transaction: func(ctx context.Context, in *services.Transaction, opts ...grpc.CallOption) (*services.TransactionResponse, error) {
+			if tx.grpcMethod == "" {
+				return nil, errUnknownTransactionMethod
+			}
+
+			out := new(services.TransactionResponse)
+			if err := channel.client.Invoke(ctx, tx.grpcMethod, in, out, opts...); err != nil {
+				return nil, err
+			}
+			return out, nil
+		},
+	}
+}
+
+func (tx UnknownTransaction) constructScheduleProtobuf() (*services.SchedulableTransactionBody, error) {
+	return tx.buildScheduled()
+}
+
+func (tx UnknownTransaction) validateNetworkOnIDs(client *Client) error {
+	return nil
+}
+
+func (tx UnknownTransaction) getBaseTransaction() *Transaction[TransactionInterface] {
+	return castFromConcreteToBaseTransaction(tx.Transaction, &tx)
+}
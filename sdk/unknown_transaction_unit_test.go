@@ -0,0 +1,206 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"testing"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/sdk"
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+func _UnknownTransactionBody(t *testing.T, fieldNumber protowire.Number, data []byte) []byte {
+	body := &services.TransactionBody{
+		TransactionID:            testTransactionID._ToProtobuf(),
+		NodeAccountID:            AccountID{Account: 3}._ToProtobuf(),
+		TransactionFee:           200000000,
+		TransactionValidDuration: &services.Duration{Seconds: 120},
+		Memo:                     "future",
+	}
+	unknown := protowire.AppendTag(nil, fieldNumber, protowire.BytesType)
+	body.ProtoReflect().SetUnknown(protowire.AppendBytes(unknown, data))
+
+	bodyBytes, err := protobuf.Marshal(body)
+	require.NoError(t, err)
+
+	return bodyBytes
+}
+
+func _UnknownTransactionBytes(t *testing.T, fieldNumber protowire.Number, data []byte, key PrivateKey) []byte {
+	return _SignedTransactionListBytes(t, _UnknownTransactionBody(t, fieldNumber, data), key)
+}
+
+func _SignedTransactionListBytes(t *testing.T, bodyBytes []byte, key PrivateKey) []byte {
+	signedTransactionBytes, err := protobuf.Marshal(&services.SignedTransaction{
+		BodyBytes: bodyBytes,
+		SigMap: &services.SignatureMap{SigPair: []*services.SignaturePair{
+			key.PublicKey()._ToSignaturePairProtobuf(key.Sign(bodyBytes)),
+		}},
+	})
+	require.NoError(t, err)
+
+	list, err := protobuf.Marshal(&sdk.TransactionList{TransactionList: []*services.Transaction{
+		{SignedTransactionBytes: signedTransactionBytes},
+	}})
+	require.NoError(t, err)
+
+	return list
+}
+
+func TestUnitUnknownTransactionRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	data := _UnknownTransactionBytes(t, 2000, []byte{0x08, 0x2a}, key)
+
+	tx, err := TransactionFromBytes(data)
+	require.NoError(t, err)
+
+	unknown, ok := tx.(UnknownTransaction)
+	require.True(t, ok)
+	assert.Equal(t, int32(2000), unknown.GetDataFieldNumber())
+	assert.Equal(t, []byte{0x08, 0x2a}, unknown.GetDataBytes())
+	assert.Equal(t, "future", unknown.GetTransactionMemo())
+	assert.Equal(t, testTransactionID.String(), unknown.GetTransactionID().String())
+
+	serialized, err := unknown.ToBytes()
+	require.NoError(t, err)
+	assert.Equal(t, data, serialized)
+
+	otherKey, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	unknown.Sign(otherKey)
+
+	thirdKey, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	unknown.AddSignature(thirdKey.PublicKey(), thirdKey.Sign(unknown.GetTransactionBodyBytes()))
+
+	signed, err := unknown.ToBytes()
+	require.NoError(t, err)
+
+	reparsed, err := TransactionFromBytes(signed)
+	require.NoError(t, err)
+	reparsedUnknown := reparsed.(UnknownTransaction)
+	signatures, err := reparsedUnknown.GetSignatures()
+	require.NoError(t, err)
+	assert.Len(t, signatures[AccountID{Account: 3}], 3)
+
+	for _, signature := range signatures[AccountID{Account: 3}] {
+		assert.Len(t, signature, 64)
+	}
+	assert.Equal(t, []byte{0x08, 0x2a}, reparsedUnknown.GetDataBytes())
+
+	// Every path which submits the transaction fails before the request is sent without a gRPC method
+	client := ClientForNetwork(map[string]AccountID{"127.0.0.1:50211": {Account: 3}})
+	_, err = unknown.Execute(client)
+	require.ErrorIs(t, err, errUnknownTransactionMethod)
+	_, err = TransactionExecute(&unknown, client)
+	require.ErrorIs(t, err, errUnknownTransactionMethod)
+}
+
+func TestUnitUnknownTransactionDecoder(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	RegisterTransactionDecoder(2001, func(tx UnknownTransaction) (TransactionInterface, error) {
+		return NewPrngTransaction().SetRange(uint32(tx.GetDataBytes()[1])), nil
+	})
+
+	tx, err := TransactionFromBytes(_UnknownTransactionBytes(t, 2001, []byte{0x08, 0x07}, key))
+	require.NoError(t, err)
+
+	prng, ok := tx.(*PrngTransaction)
+	require.True(t, ok)
+	assert.Equal(t, uint32(7), prng.GetRange())
+}
+
+func TestUnitUnknownTransactionScheduled(t *testing.T) {
+	t.Parallel()
+
+	scheduledBody := &services.SchedulableTransactionBody{Memo: "scheduled", TransactionFee: 10}
+	unknown := protowire.AppendTag(nil, 2002, protowire.BytesType)
+	scheduledBody.ProtoReflect().SetUnknown(protowire.AppendBytes(unknown, []byte{0x10, 0x01}))
+
+	info := ScheduleInfo{scheduledTransactionBody: scheduledBody}
+	tx, err := info.GetScheduledTransaction()
+	require.NoError(t, err)
+
+	unknownTx, ok := tx.(UnknownTransaction)
+	require.True(t, ok)
+	assert.Equal(t, int32(2002), unknownTx.GetDataFieldNumber())
+	assert.Equal(t, "scheduled", unknownTx.GetTransactionMemo())
+
+	rebuilt, err := unknownTx.buildScheduled()
+	require.NoError(t, err)
+	assert.True(t, protobuf.Equal(scheduledBody, rebuilt))
+}
+
+func TestUnitUnknownTransactionKeepsBodyBytes(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	// The unknown field comes before max_custom_fees (1001) on the wire, the reverse of the order Go marshals them in
+	customFees, err := protobuf.Marshal(&services.TransactionBody{MaxCustomFees: []*services.CustomFeeLimit{{
+		AccountId: AccountID{Account: 5}._ToProtobuf(),
+		Fees:      []*services.FixedFee{{Amount: 10}},
+	}}})
+	require.NoError(t, err)
+	bodyBytes := append(_UnknownTransactionBody(t, 500, []byte{0x08, 0x2a}), customFees...)
+
+	tx, err := TransactionFromBytes(_SignedTransactionListBytes(t, bodyBytes, key))
+	require.NoError(t, err)
+	unknown := tx.(UnknownTransaction)
+	require.Len(t, unknown.customFeeLimits, 1)
+
+	otherKey, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	unknown.Sign(otherKey)
+
+	signed, err := unknown.ToBytes()
+	require.NoError(t, err)
+
+	reparsed, err := TransactionFromBytes(signed)
+	require.NoError(t, err)
+	reparsedUnknown := reparsed.(UnknownTransaction)
+	assert.Equal(t, bodyBytes, reparsedUnknown.signedTransactions._Get(0).(*services.SignedTransaction).BodyBytes)
+
+	signatures, err := reparsedUnknown.GetSignatures()
+	require.NoError(t, err)
+	require.Len(t, signatures[AccountID{Account: 3}], 2)
+	for publicKey, signature := range signatures[AccountID{Account: 3}] {
+		assert.True(t, publicKey.Verify(bodyBytes, signature))
+	}
+}
+
+func TestUnitUnknownTransactionWithoutData(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	bodyBytes, err := protobuf.Marshal(&services.TransactionBody{
+		TransactionID: testTransactionID._ToProtobuf(),
+		NodeAccountID: AccountID{Account: 3}._ToProtobuf(),
+	})
+	require.NoError(t, err)
+
+	_, err = TransactionFromBytes(_SignedTransactionListBytes(t, bodyBytes, key))
+	require.ErrorIs(t, err, errFailedToDeserializeBytes)
+
+	info := ScheduleInfo{scheduledTransactionBody: &services.SchedulableTransactionBody{Memo: "empty"}}
+	_, err = info.GetScheduledTransaction()
+	require.ErrorIs(t, err, errUnrecognizedTransactionType)
+}